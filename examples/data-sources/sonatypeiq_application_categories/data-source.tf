@@ -1,4 +1,11 @@
 # Get Application Categories for the Root Organization
 data "sonatypeiq_application_categories" "categories" {
   organization_id = "ROOT_ORGANIZATION_ID"
-}
\ No newline at end of file
+}
+
+# Get all Application Categories available to an Organization, including those
+# defined on its parent Organizations
+data "sonatypeiq_application_categories" "effective" {
+  organization_id   = "b4b6d5a3f5b84b3e9b9f0d0b41c1b0b5"
+  include_inherited = true
+}
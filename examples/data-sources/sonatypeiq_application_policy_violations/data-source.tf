@@ -0,0 +1,13 @@
+# Get Policy Violations from the latest build evaluation of an Application
+data "sonatypeiq_application_policy_violations" "app" {
+  public_id = "sandbox-application"
+  stage     = "build"
+}
+
+# Critical Policy Violations that have not been waived
+output "critical_violations" {
+  value = [
+    for v in data.sonatypeiq_application_policy_violations.app.violations : "${v.policy_name}: ${v.component_purl}"
+    if v.threat_level >= 8 && !v.waived && !v.legacy_violation
+  ]
+}
@@ -0,0 +1,10 @@
+# Get the 10 most recent release evaluation reports for an Application
+data "sonatypeiq_application_reports" "app" {
+  public_id   = "sandbox-application"
+  stage       = "release"
+  max_results = 10
+}
+
+output "latest_release_report" {
+  value = data.sonatypeiq_application_reports.app.reports[0].report_html_url
+}
@@ -0,0 +1,13 @@
+# Export a CycloneDX SBOM from the latest release evaluation of an Application
+data "sonatypeiq_application_sbom_export" "release" {
+  public_id    = "sandbox-application"
+  stage        = "release"
+  format       = "cyclonedx-json"
+  spec_version = "1.5"
+}
+
+# Write the SBOM alongside the release artifacts
+resource "local_file" "sbom" {
+  content  = data.sonatypeiq_application_sbom_export.release.content
+  filename = "${path.module}/dist/bom.json"
+}
@@ -1,2 +1,10 @@
 # Get all Applications
-data "sonatypeiq_applications" "apps" {}
\ No newline at end of file
+data "sonatypeiq_applications" "apps" {}
+
+# Get up to 50 Applications beneath an Organization whose Public ID starts with "payments-"
+data "sonatypeiq_applications" "payments" {
+  organization_id           = "b4b6d5a3f5b84b3e9b9f0d0b41c1b0b5"
+  include_sub_organizations = true
+  public_id_prefix          = "payments-"
+  max_results               = 50
+}
@@ -0,0 +1,13 @@
+# Get the Audit Log for January 2025
+data "sonatypeiq_audit_log" "january" {
+  start_date = "2025-01-01"
+  end_date   = "2025-01-31"
+}
+
+# Everything done by the admin user
+output "admin_actions" {
+  value = [
+    for e in data.sonatypeiq_audit_log.january.entries : "${e.timestamp} ${e.action} ${e.domain}"
+    if e.actor == "admin"
+  ]
+}
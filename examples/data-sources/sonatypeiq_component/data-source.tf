@@ -0,0 +1,12 @@
+# Get Sonatype intelligence for a Component
+data "sonatypeiq_component" "commons_collections" {
+  package_url = "pkg:maven/commons-collections/commons-collections@3.2.1?type=jar"
+}
+
+# Refuse to use a Component with known critical Security Issues
+check "no_critical_security_issues" {
+  assert {
+    condition     = alltrue([for issue in data.sonatypeiq_component.commons_collections.security_issues : issue.severity < 9])
+    error_message = "Component has critical Security Issues"
+  }
+}
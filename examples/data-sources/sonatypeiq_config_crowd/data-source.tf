@@ -0,0 +1,2 @@
+# Get the Atlassian Crowd configuration
+data "sonatypeiq_config_crowd" "crowd" {}
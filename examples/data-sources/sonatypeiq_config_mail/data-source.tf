@@ -0,0 +1,2 @@
+# Get the outbound email server configuration
+data "sonatypeiq_config_mail" "mail" {}
@@ -0,0 +1,6 @@
+# Get details of the installed Product License
+data "sonatypeiq_config_product_license" "license" {}
+
+output "license_expiry_date" {
+  value = data.sonatypeiq_config_product_license.license.expiry_date
+}
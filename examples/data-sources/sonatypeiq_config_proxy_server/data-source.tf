@@ -0,0 +1,2 @@
+# Get the outbound Proxy Server configuration
+data "sonatypeiq_config_proxy_server" "proxy" {}
@@ -0,0 +1,16 @@
+# Get the effective Permissions of a user for an Application
+data "sonatypeiq_effective_permissions" "alice" {
+  owner_type = "application"
+  owner_id   = "4537e6fe68c24dd5ac83efd97d4fc2f4"
+  user_name  = "alice"
+}
+
+output "alice_can_waive" {
+  value = contains(data.sonatypeiq_effective_permissions.alice.permissions, "WAIVE_POLICY_VIOLATIONS")
+}
+
+# Get the global Permissions of a group
+data "sonatypeiq_effective_permissions" "admins" {
+  owner_type = "global"
+  group_name = "iq-admins"
+}
@@ -0,0 +1,11 @@
+# Get the License Threat Groups for the Root Organization
+data "sonatypeiq_license_threat_groups" "root" {
+  organization_id = "ROOT_ORGANIZATION_ID"
+}
+
+# Licenses in License Threat Groups with a threat level of 7 or higher
+locals {
+  high_threat_license_ids = flatten([
+    for group in data.sonatypeiq_license_threat_groups.root.license_threat_groups : group.license_ids if group.threat_level >= 7
+  ])
+}
@@ -0,0 +1,6 @@
+# Get all licenses known to Sonatype IQ Server
+data "sonatypeiq_licenses" "all" {}
+
+locals {
+  known_license_ids = toset(data.sonatypeiq_licenses.all.licenses[*].id)
+}
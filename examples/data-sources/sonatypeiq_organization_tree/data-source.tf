@@ -0,0 +1,11 @@
+# Get the hierarchy of all Organizations
+data "sonatypeiq_organization_tree" "tree" {}
+
+locals {
+  organizations_by_path = { for o in data.sonatypeiq_organization_tree.tree.organizations : o.path => o }
+}
+
+# Every Application beneath the Payments Organization, at any depth
+output "payments_application_ids" {
+  value = local.organizations_by_path["Root Organization/Payments"].application_ids
+}
@@ -0,0 +1,13 @@
+# Get all Policies in effect for an Organization, including inherited Policies
+data "sonatypeiq_policies" "business_unit" {
+  organization_id = "b4b6d5a3f5b84b3e9b9f0d0b41c1b0b5"
+}
+
+# Policies that fail the build for an Application
+data "sonatypeiq_policies" "app" {
+  application_id = "4537e6fe68c24dd5ac83efd97d4fc2f4"
+}
+
+output "build_failing_policies" {
+  value = [for p in data.sonatypeiq_policies.app.policies : p.name if lookup(coalesce(p.actions, {}), "build", "") == "fail"]
+}
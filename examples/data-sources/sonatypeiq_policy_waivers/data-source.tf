@@ -0,0 +1,20 @@
+# List the Policy Waivers for an Application
+data "sonatypeiq_policy_waivers" "app" {
+  owner_type = "application"
+  owner_id   = "4537e6fe68c24dd5ac83efd97d4fc2f4"
+}
+
+# Flag waivers anywhere in the Organization hierarchy that lapse in the next 14 days
+data "sonatypeiq_policy_waivers" "expiring" {
+  owner_type           = "organization"
+  owner_id             = "ROOT_ORGANIZATION_ID"
+  include_descendants  = true
+  expiring_within_days = 14
+}
+
+check "no_expiring_waivers" {
+  assert {
+    condition     = length(data.sonatypeiq_policy_waivers.expiring.waivers) == 0
+    error_message = "Policy Waivers expiring soon: ${join(", ", [for w in data.sonatypeiq_policy_waivers.expiring.waivers : "${w.policy_name} on ${w.component_name} (${w.scope_owner_name}) expires ${w.expiry_time}"])}"
+  }
+}
@@ -0,0 +1,21 @@
+# Get all Role memberships for an Application, including those inherited from parent Organizations
+data "sonatypeiq_role_memberships" "app" {
+  owner_type = "application"
+  owner_id   = "370bf138ffa0429791b7c269cd8edbb9"
+}
+
+# Get all Role memberships for the Root Organization
+data "sonatypeiq_role_memberships" "root" {
+  owner_type = "organization"
+  owner_id   = "ROOT_ORGANIZATION_ID"
+}
+
+# Members granted a Role directly on the Application
+output "direct_members" {
+  value = flatten([
+    for membership in data.sonatypeiq_role_memberships.app.memberships : [
+      for member in membership.members : "${membership.role_name}: ${member.type} ${member.name}"
+      if !member.inherited
+    ]
+  ])
+}
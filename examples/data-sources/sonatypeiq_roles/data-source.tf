@@ -0,0 +1,11 @@
+# Get all Roles
+data "sonatypeiq_roles" "all" {
+}
+
+# Roles that can waive Policy Violations
+output "roles_that_can_waive" {
+  value = [
+    for role in data.sonatypeiq_roles.all.roles : role.name
+    if role.permissions.remediation.waive_policy_violations
+  ]
+}
@@ -0,0 +1,12 @@
+# Get information about the connected Sonatype IQ Server
+data "sonatypeiq_server" "server" {}
+
+# Only manage User Token expiry on release 190 or newer
+resource "sonatypeiq_config_user_token" "user_tokens" {
+  count                   = data.sonatypeiq_server.server.version_minor >= 190 ? 1 : 0
+  default_expiration_days = 90
+}
+
+output "iq_version" {
+  value = data.sonatypeiq_server.server.version
+}
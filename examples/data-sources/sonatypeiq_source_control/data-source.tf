@@ -0,0 +1,9 @@
+# Get the effective Source Control configuration for an Application
+data "sonatypeiq_source_control" "app" {
+  owner_type = "application"
+  owner_id   = "370bf138ffa0429791b7c269cd8edbb9"
+}
+
+output "base_branch" {
+  value = data.sonatypeiq_source_control.app.base_branch.inherited ? "${data.sonatypeiq_source_control.app.base_branch.value} (from ${data.sonatypeiq_source_control.app.base_branch.inherited_from})" : data.sonatypeiq_source_control.app.base_branch.value
+}
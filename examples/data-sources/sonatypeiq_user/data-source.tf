@@ -0,0 +1,10 @@
+# Get a User from the Internal Realm
+data "sonatypeiq_user" "admin" {
+  username = "admin"
+}
+
+# Get a User from the SAML Realm
+data "sonatypeiq_user" "saml_user" {
+  username = "jane.doe"
+  realm    = "SAML"
+}
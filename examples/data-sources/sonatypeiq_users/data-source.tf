@@ -0,0 +1,8 @@
+# Get all Users in the Internal Realm
+data "sonatypeiq_users" "all" {
+}
+
+# Find Users whose username, name or email address contains "example.com"
+data "sonatypeiq_users" "example" {
+  search = "example.com"
+}
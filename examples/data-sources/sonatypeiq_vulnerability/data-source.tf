@@ -0,0 +1,13 @@
+# Get details of a Vulnerability by CVE ID
+data "sonatypeiq_vulnerability" "log4shell" {
+  identifier = "CVE-2021-44228"
+}
+
+# Get details of a Vulnerability by Sonatype Vulnerability Identifier
+data "sonatypeiq_vulnerability" "sonatype" {
+  identifier = "sonatype-2015-0002"
+}
+
+output "log4shell_cvss" {
+  value = data.sonatypeiq_vulnerability.log4shell.main_severity.score
+}
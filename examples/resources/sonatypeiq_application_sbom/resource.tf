@@ -0,0 +1,22 @@
+data "sonatypeiq_application" "vendor_app" {
+  public_id = "vendor-app"
+}
+
+# Import an SBOM from a local file
+resource "sonatypeiq_application_sbom" "vendor_app_1_2_0" {
+  application_id      = data.sonatypeiq_application.vendor_app.id
+  application_version = "1.2.0"
+  file_path           = "${path.module}/sboms/vendor-app-1.2.0.cdx.json"
+}
+
+# Import an SBOM supplied as content
+resource "sonatypeiq_application_sbom" "vendor_app_1_3_0" {
+  application_id      = data.sonatypeiq_application.vendor_app.id
+  application_version = "1.3.0"
+  content             = file("${path.module}/sboms/vendor-app-1.3.0.spdx.json")
+
+  # Large SBOMs can take a while for Sonatype IQ Server to process
+  timeouts = {
+    create = "15m"
+  }
+}
@@ -0,0 +1,4 @@
+# System Feature Configuration can be imported - all features reported by Sonatype IQ Server are imported.
+
+# Example
+terraform import sonatypeiq_config_features.features system-feature-configuration
@@ -0,0 +1,7 @@
+# Enable and disable IQ Server features
+resource "sonatypeiq_config_features" "features" {
+  features = {
+    "feature-name-to-enable"  = true
+    "feature-name-to-disable" = false
+  }
+}
@@ -0,0 +1,4 @@
+# System Jira Configuration can be imported.
+
+# Example
+terraform import sonatypeiq_config_jira.config system-jira-configuration
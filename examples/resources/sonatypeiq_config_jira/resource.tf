@@ -0,0 +1,13 @@
+# Create and manage Jira Configuration for Sonatype IQ Server
+variable "jira_api_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "sonatypeiq_config_jira" "jira_config" {
+  url               = "https://my-company.atlassian.net"
+  username          = "iq-server@my-domain.tld"
+  api_token         = var.jira_api_token
+  api_token_version = 1 # Increment when the API Token is rotated
+}
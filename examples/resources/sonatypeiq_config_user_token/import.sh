@@ -0,0 +1,4 @@
+# System User Token Configuration can be imported.
+
+# Example
+terraform import sonatypeiq_config_user_token.user_tokens system-user-token-configuration
@@ -0,0 +1,4 @@
+# Require User Tokens to expire after 90 days
+resource "sonatypeiq_config_user_token" "user_tokens" {
+  default_expiration_days = 90
+}
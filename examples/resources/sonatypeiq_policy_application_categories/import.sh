@@ -0,0 +1,5 @@
+# Application Categories applied to a Policy can be imported using the Organization id and Policy id
+# separated by a comma. These can be obtained from the WebUI or by calling the Sonatype IQ REST API.
+
+# Example
+terraform import sonatypeiq_policy_application_categories.pci_only ROOT_ORGANIZATION_ID,d4a6d5e2b4a14e1a8b3f6e1c2a9b7f01
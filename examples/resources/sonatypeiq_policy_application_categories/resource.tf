@@ -0,0 +1,18 @@
+data "sonatypeiq_organization" "root" {
+  id = "ROOT_ORGANIZATION_ID"
+}
+
+resource "sonatypeiq_application_category" "pci" {
+  name            = "PCI"
+  description     = "Applications in scope for PCI-DSS"
+  organization_id = data.sonatypeiq_organization.root.id
+  color           = "dark-red"
+}
+
+resource "sonatypeiq_policy_application_categories" "pci_only" {
+  policy_id       = "d4a6d5e2b4a14e1a8b3f6e1c2a9b7f01"
+  organization_id = data.sonatypeiq_organization.root.id
+  category_ids = [
+    sonatypeiq_application_category.pci.id
+  ]
+}
@@ -0,0 +1,5 @@
+# A Policy Bundle can be imported using the Organization id.
+# The current Policies of the Organization are exported into state.
+
+# Example
+terraform import sonatypeiq_policy_bundle.root ROOT_ORGANIZATION_ID
@@ -0,0 +1,6 @@
+# Policies exported from the Root Organization of another Sonatype IQ Server
+# (Organization & Policies -> Root Organization -> Export)
+resource "sonatypeiq_policy_bundle" "root" {
+  organization_id = "ROOT_ORGANIZATION_ID"
+  policy_json     = file("${path.module}/policies.json")
+}
@@ -27,6 +27,7 @@ import (
 	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
 	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
 	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
 )
@@ -55,12 +56,13 @@ func (d *applicationCategoriesDataSource) Metadata(_ context.Context, req dataso
 // Schema defines the schema for the data source.
 func (d *applicationCategoriesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = tfschema.Schema{
-		Description: "Use this data source to get Application Categories for an Organization",
+		Description: "Use this data source to get Application Categories for an Organization, optionally including those inherited from parent Organizations",
 		Attributes: map[string]tfschema.Attribute{
-			"id":              schema.DataSourceComputedString("The ID of this resource."),
-			"organization_id": schema.DataSourceRequiredString("Internal ID of the Organization to which this Application belongs - use `ROOT_ORGANIZATION_ID` for the Root Organization"),
+			"id":                schema.DataSourceComputedString("The ID of this resource."),
+			"organization_id":   schema.DataSourceRequiredString("Internal ID of the Organization to which this Application belongs - use `ROOT_ORGANIZATION_ID` for the Root Organization"),
+			"include_inherited": schema.DataSourceOptionalBool("Also return Application Categories defined on parent Organizations up to the Root Organization - defaults to `false`. Each Category's `organization_id` identifies the Organization that defines it."),
 			"categories": schema.DataSourceComputedListNestedAttribute(
-				"List of Categories defined for this Organization",
+				"List of Categories available to this Organization - this Organization's own Categories first, followed by those of each parent Organization in turn",
 				tfschema.NestedAttributeObject{
 					Attributes: map[string]tfschema.Attribute{
 						"id":              schema.DataSourceComputedString("Internal ID of the Application Category"),
@@ -85,24 +87,50 @@ func (d *applicationCategoriesDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
-	apiResponse, httpResponse, err := d.Client.ApplicationCategoriesAPI.GetTags(d.AuthContext(ctx), data.OrganiziationId.ValueString()).Execute()
+	// Walk up the Organization hierarchy if inherited Categories are requested
+	organizationIds := []string{data.OrganiziationId.ValueString()}
+	if data.IncludeInherited.ValueBool() {
+		var httpResponse *http.Response
+		var err error
+		organizationIds, httpResponse, err = common.ReadOrganizationAncestry(ctx, d.Client, d.Auth, data.OrganiziationId.ValueString())
+		if err != nil {
+			if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+				errors.AddValidationDiagnostic(&resp.Diagnostics, "organization_id", fmt.Sprintf(common.ERR_ORGANIZATION_DID_NOT_EXIST, data.OrganiziationId.ValueString()))
+			} else {
+				errors.HandleAPIError(common.ERR_FAILED_READING_ORGANIZATION, &err, httpResponse, &resp.Diagnostics)
+			}
+			return
+		}
+	}
 
-	if err != nil {
-		errors.HandleAPIError(
-			common.ERR_FAILED_READING_APPLICATION_CATEGORIES_FOR_ORG,
-			&err,
-			httpResponse,
-			&resp.Diagnostics,
-		)
-		return
-	} else if httpResponse.StatusCode != http.StatusOK {
-		errors.AddAPIErrorDiagnostic(&resp.Diagnostics, "read", "Application Categories", httpResponse, err)
-		return
+	categories := make([]sonatypeiq.ApiApplicationCategoryDTO, 0)
+	for _, organizationId := range organizationIds {
+		apiResponse, httpResponse, err := d.Client.ApplicationCategoriesAPI.GetTags(d.AuthContext(ctx), organizationId).Execute()
+
+		if err != nil {
+			errors.HandleAPIError(
+				common.ERR_FAILED_READING_APPLICATION_CATEGORIES_FOR_ORG,
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+			return
+		} else if httpResponse.StatusCode != http.StatusOK {
+			errors.AddAPIErrorDiagnostic(&resp.Diagnostics, "read", "Application Categories", httpResponse, err)
+			return
+		}
+
+		for _, category := range apiResponse {
+			if category.OrganizationId == nil {
+				category.OrganizationId = sonatypeiq.PtrString(organizationId)
+			}
+			categories = append(categories, category)
+		}
 	}
 
 	// Assign Response Data to State
 	data.ID = types.StringValue(fmt.Sprintf("application-categories-%s", data.OrganiziationId.ValueString()))
-	data.MapFromApi(&apiResponse)
+	data.MapFromApi(&categories)
 
 	// Set state
 	diags := resp.State.Set(ctx, &data)
@@ -60,6 +60,24 @@ func TestAccApplicationCategoriesDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "categories.2.color", "dark-green"),
 				),
 			},
+			// Inherited Categories
+			{
+				Config: utils_test.ProviderConfig + `data "sonatypeiq_organization" "sandbox" {
+					name = "Sandbox Organization"
+				}
+
+				data "sonatypeiq_application_categories" "inherited" {
+					organization_id   = data.sonatypeiq_organization.sandbox.id
+					include_inherited = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonatypeiq_application_categories.inherited", "include_inherited", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonatypeiq_application_categories.inherited", "categories.*", map[string]string{
+						"name":            "Distributed",
+						"organization_id": common.ROOT_ORGANIZATION_ID,
+					}),
+				),
+			},
 		},
 	})
 }
@@ -25,6 +25,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
 	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
@@ -89,31 +91,8 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Lookup
-	var foundApplication *sonatypeiq.ApiApplicationDTO
-	var httpResponse *http.Response
-	var err error
-	if !data.ID.IsNull() {
-		foundApplication, httpResponse, err = d.Client.ApplicationsAPI.GetApplication(d.AuthContext(ctx), data.ID.ValueString()).Execute()
-
-		if err != nil || httpResponse.StatusCode != http.StatusOK {
-			errors.HandleAPIError("Unable to read IQ Application by ID", &err, httpResponse, &resp.Diagnostics)
-			return
-		}
-	} else if !data.PublicId.IsNull() {
-		var apiResponse *sonatypeiq.ApiApplicationListDTO
-		apiResponse, httpResponse, err = d.Client.ApplicationsAPI.GetApplications(d.AuthContext(ctx)).PublicId([]string{data.PublicId.ValueString()}).Execute()
-
-		if err != nil || httpResponse.StatusCode != http.StatusOK {
-			errors.HandleAPIError("Unable to read IQ Applications to find by Public ID", &err, httpResponse, &resp.Diagnostics)
-			return
-		} else if len(apiResponse.Applications) != 1 {
-			errors.HandleAPIWarning("No unique Application found with supplied Public ID", nil, httpResponse, &resp.Diagnostics)
-			return
-		}
-
-		foundApplication = &apiResponse.Applications[0]
-	} else {
-		errors.AddValidationDiagnostic(&resp.Diagnostics, "Application Lookup", "ID or Public ID must be provided")
+	foundApplication := lookupApplication(ctx, &d.BaseDataSource, data.ID, data.PublicId, &resp.Diagnostics)
+	if foundApplication == nil {
 		return
 	}
 
@@ -127,3 +106,32 @@ func (d *applicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 }
+
+// lookupApplication finds an Application by Internal ID or, failing that, by Public ID
+func lookupApplication(ctx context.Context, d *common.BaseDataSource, id types.String, publicId types.String, respDiags *diag.Diagnostics) *sonatypeiq.ApiApplicationDTO {
+	if !id.IsNull() {
+		foundApplication, httpResponse, err := d.Client.ApplicationsAPI.GetApplication(d.AuthContext(ctx), id.ValueString()).Execute()
+
+		if err != nil || httpResponse.StatusCode != http.StatusOK {
+			errors.HandleAPIError("Unable to read IQ Application by ID", &err, httpResponse, respDiags)
+			return nil
+		}
+
+		return foundApplication
+	} else if !publicId.IsNull() {
+		apiResponse, httpResponse, err := d.Client.ApplicationsAPI.GetApplications(d.AuthContext(ctx)).PublicId([]string{publicId.ValueString()}).Execute()
+
+		if err != nil || httpResponse.StatusCode != http.StatusOK {
+			errors.HandleAPIError("Unable to read IQ Applications to find by Public ID", &err, httpResponse, respDiags)
+			return nil
+		} else if len(apiResponse.Applications) != 1 {
+			errors.HandleAPIWarning("No unique Application found with supplied Public ID", nil, httpResponse, respDiags)
+			return nil
+		}
+
+		return &apiResponse.Applications[0]
+	}
+
+	errors.AddValidationDiagnostic(respDiags, "Application Lookup", "ID or Public ID must be provided")
+	return nil
+}
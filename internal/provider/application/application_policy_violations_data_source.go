@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &applicationPolicyViolationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &applicationPolicyViolationsDataSource{}
+)
+
+// ApplicationPolicyViolationsDataSource is a helper function to simplify the provider implementation.
+func ApplicationPolicyViolationsDataSource() datasource.DataSource {
+	return &applicationPolicyViolationsDataSource{}
+}
+
+// applicationPolicyViolationsDataSource is the data source implementation.
+type applicationPolicyViolationsDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *applicationPolicyViolationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_policy_violations"
+}
+
+// Schema defines the schema for the data source.
+func (d *applicationPolicyViolationsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Lookup attributes are also set from the evaluation that is returned
+	applicationId := schema.DataSourceOptionalString("Internal ID of the Application - one of `application_id` or `public_id` must be set")
+	applicationId.Computed = true
+	publicId := schema.DataSourceOptionalString("Public ID of the Application - one of `application_id` or `public_id` must be set")
+	publicId.Computed = true
+	stage := schema.DataSourceOptionalStringEnum(
+		"Stage to return the most recent evaluation for - defaults to the most recent evaluation at any stage, in which case this is set to the stage of that evaluation",
+		common.STAGE_DEVELOP,
+		common.STAGE_SOURCE,
+		common.STAGE_BUILD,
+		common.STAGE_STAGE_RELEASE,
+		common.STAGE_RELEASE,
+		common.STAGE_OPERATE,
+	)
+	stage.Computed = true
+
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get the Policy Violations from the most recent evaluation of an Application",
+		Attributes: map[string]tfschema.Attribute{
+			"id":              schema.DataSourceComputedString("Internal ID of the evaluation report"),
+			"application_id":  applicationId,
+			"public_id":       publicId,
+			"stage":           stage,
+			"scan_id":         schema.DataSourceComputedString("Scan ID of the evaluation"),
+			"evaluation_date": schema.DataSourceComputedString("Date and time of the evaluation"),
+			"violations": schema.DataSourceComputedListNestedAttribute(
+				"List of Policy Violations found by the evaluation",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"policy_violation_id":    schema.DataSourceComputedString("Internal ID of the Policy Violation"),
+						"policy_id":              schema.DataSourceComputedString("Internal ID of the Policy"),
+						"policy_name":            schema.DataSourceComputedString("Name of the Policy"),
+						"threat_level":           schema.DataSourceComputedInt32("Threat Level of the Policy (0-10)"),
+						"threat_category":        schema.DataSourceComputedString("Threat Category of the Policy"),
+						"component_display_name": schema.DataSourceComputedString("Display Name of the Component in violation"),
+						"component_purl":         schema.DataSourceComputedString("Package URL of the Component in violation"),
+						"waived":                 schema.DataSourceComputedBool("Whether the Policy Violation is waived"),
+						"legacy_violation":       schema.DataSourceComputedBool("Whether the Policy Violation is a Legacy Violation"),
+						"grandfathered":          schema.DataSourceComputedBool("Whether the Policy Violation is grandfathered"),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *applicationPolicyViolationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.ApplicationPolicyViolationsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	// Lookup
+	foundApplication := lookupApplication(ctx, &d.BaseDataSource, data.ApplicationId, data.PublicId, &resp.Diagnostics)
+	if foundApplication == nil {
+		return
+	}
+
+	report := latestReport(ctx, &d.BaseDataSource, foundApplication.GetId(), data.Stage.ValueString(), &resp.Diagnostics)
+	if report == nil {
+		return
+	}
+
+	apiResponse, httpResponse, err := d.Client.ApplicationReportDataAPI.GetPolicyViolations1(
+		d.AuthContext(ctx), foundApplication.GetPublicId(), report.GetScanId(),
+	).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(common.ERR_FAILED_READING_POLICY_VIOLATIONS, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+
+	// Map api response to State
+	data.MapFromApi(foundApplication, report, apiResponse)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// latestReport returns the most recent evaluation report for an Application, optionally at a given stage
+func latestReport(ctx context.Context, d *common.BaseDataSource, applicationId string, stage string, respDiags *diag.Diagnostics) *sonatypeiq.ApiReportResultsDTO {
+	request := d.Client.ReportsAPI.GetReportHistoryForApplication(d.AuthContext(ctx), applicationId).Limit(1)
+	if stage != "" {
+		request = request.Stage(stage)
+	}
+	apiResponse, httpResponse, err := request.Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(common.ERR_FAILED_READING_REPORT_HISTORY, &err, httpResponse, respDiags)
+		return nil
+	}
+
+	if len(apiResponse.Reports) == 0 {
+		if stage != "" {
+			respDiags.AddError("No evaluation found", fmt.Sprintf("Application %s has not been evaluated at stage %q", applicationId, stage))
+		} else {
+			respDiags.AddError("No evaluation found", fmt.Sprintf("Application %s has not been evaluated", applicationId))
+		}
+		return nil
+	}
+
+	return &apiResponse.Reports[0]
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package application_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationPolicyViolationsDataSource(t *testing.T) {
+	// Public ID of an Application that has been evaluated at the build stage
+	publicId := os.Getenv("IQ_TEST_EVALUATED_APPLICATION_PUBLIC_ID")
+	if publicId == "" {
+		t.Skip("IQ_TEST_EVALUATED_APPLICATION_PUBLIC_ID not set - skipping")
+	}
+
+	resourceName := "data.sonatypeiq_application_policy_violations.violations"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read by Public ID
+			{
+				Config: utils_test.ProviderConfig + fmt.Sprintf(`data "sonatypeiq_application_policy_violations" "violations" {
+					public_id = "%s"
+					stage     = "build"
+				}`, publicId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "application_id"),
+					resource.TestCheckResourceAttr(resourceName, "public_id", publicId),
+					resource.TestCheckResourceAttr(resourceName, "stage", "build"),
+					resource.TestCheckResourceAttrSet(resourceName, "scan_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "evaluation_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "violations.#"),
+				),
+			},
+			// Read the most recent evaluation at any stage
+			{
+				Config: utils_test.ProviderConfig + fmt.Sprintf(`data "sonatypeiq_application_policy_violations" "violations" {
+					public_id = "%s"
+				}`, publicId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "application_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "stage"),
+					resource.TestCheckResourceAttrSet(resourceName, "scan_id"),
+				),
+			},
+		},
+	})
+}
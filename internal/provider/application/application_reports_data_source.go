@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &applicationReportsDataSource{}
+	_ datasource.DataSourceWithConfigure = &applicationReportsDataSource{}
+)
+
+// ApplicationReportsDataSource is a helper function to simplify the provider implementation.
+func ApplicationReportsDataSource() datasource.DataSource {
+	return &applicationReportsDataSource{}
+}
+
+// applicationReportsDataSource is the data source implementation.
+type applicationReportsDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *applicationReportsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_reports"
+}
+
+// Schema defines the schema for the data source.
+func (d *applicationReportsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Whichever lookup attribute is not set is filled in from the Application that is found
+	applicationId := schema.DataSourceOptionalString("Internal ID of the Application - one of `application_id` or `public_id` must be set")
+	applicationId.Computed = true
+	publicId := schema.DataSourceOptionalString("Public ID of the Application - one of `application_id` or `public_id` must be set")
+	publicId.Computed = true
+
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get the history of evaluation reports for an Application, most recent first",
+		Attributes: map[string]tfschema.Attribute{
+			"id":             schema.DataSourceComputedString("Internal ID of the Application"),
+			"application_id": applicationId,
+			"public_id":      publicId,
+			"stage": schema.DataSourceOptionalStringEnum(
+				"Only return reports for this Stage",
+				common.STAGE_DEVELOP,
+				common.STAGE_SOURCE,
+				common.STAGE_BUILD,
+				common.STAGE_STAGE_RELEASE,
+				common.STAGE_RELEASE,
+				common.STAGE_OPERATE,
+			),
+			"max_results": schema.DataSourceOptionalInt32WithRange("Maximum number of reports to return", 1, math.MaxInt32),
+			"reports": schema.DataSourceComputedListNestedAttribute(
+				"List of evaluation reports for the Application",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"scan_id":                              schema.DataSourceComputedString("Scan ID of the evaluation"),
+						"stage":                                schema.DataSourceComputedString("Stage the Application was evaluated at"),
+						"evaluation_date":                      schema.DataSourceComputedString("Date and time of the evaluation"),
+						"report_html_url":                      schema.DataSourceComputedString("URL of the report in the Sonatype IQ UI"),
+						"report_pdf_url":                       schema.DataSourceComputedString("URL of the PDF report"),
+						"report_data_url":                      schema.DataSourceComputedString("URL of the report data"),
+						"affected_component_count":             schema.DataSourceComputedInt32("Number of Components with Policy Violations"),
+						"total_component_count":                schema.DataSourceComputedInt32("Total number of Components evaluated"),
+						"critical_component_count":             schema.DataSourceComputedInt32("Number of Components with Critical Policy Violations"),
+						"severe_component_count":               schema.DataSourceComputedInt32("Number of Components with Severe Policy Violations"),
+						"moderate_component_count":             schema.DataSourceComputedInt32("Number of Components with Moderate Policy Violations"),
+						"critical_policy_violation_count":      schema.DataSourceComputedInt32("Number of Critical Policy Violations"),
+						"severe_policy_violation_count":        schema.DataSourceComputedInt32("Number of Severe Policy Violations"),
+						"moderate_policy_violation_count":      schema.DataSourceComputedInt32("Number of Moderate Policy Violations"),
+						"grandfathered_policy_violation_count": schema.DataSourceComputedInt32("Number of grandfathered Policy Violations"),
+						"legacy_violation_count":               schema.DataSourceComputedInt32("Number of Legacy Violations"),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *applicationReportsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.ApplicationReportsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	// Lookup
+	foundApplication := lookupApplication(ctx, &d.BaseDataSource, data.ApplicationId, data.PublicId, &resp.Diagnostics)
+	if foundApplication == nil {
+		return
+	}
+
+	request := d.Client.ReportsAPI.GetReportHistoryForApplication(d.AuthContext(ctx), foundApplication.GetId())
+	if !data.Stage.IsNull() {
+		request = request.Stage(data.Stage.ValueString())
+	}
+	if !data.MaxResults.IsNull() {
+		request = request.Limit(data.MaxResults.ValueInt32())
+	}
+	apiResponse, httpResponse, err := request.Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(common.ERR_FAILED_READING_REPORT_HISTORY, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+
+	// Map api response to State
+	data.MapFromApi(foundApplication, apiResponse, d.BaseUrl)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package application_test
+
+import (
+	"testing"
+
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationReportsDataSource(t *testing.T) {
+	resourceName := "data.sonatypeiq_application_reports.reports"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read by Public ID
+			{
+				Config: utils_test.ProviderConfig + `data "sonatypeiq_application_reports" "reports" {
+					public_id   = "sandbox-application"
+					max_results = 5
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "application_id"),
+					resource.TestCheckResourceAttr(resourceName, "public_id", "sandbox-application"),
+					resource.TestCheckResourceAttrSet(resourceName, "reports.#"),
+				),
+			},
+			// Read by Application ID
+			{
+				Config: utils_test.ProviderConfig + `data "sonatypeiq_application" "sandbox" {
+					public_id = "sandbox-application"
+				}
+
+				data "sonatypeiq_application_reports" "reports" {
+					application_id = data.sonatypeiq_application.sandbox.id
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "data.sonatypeiq_application.sandbox", "id"),
+					resource.TestCheckResourceAttr(resourceName, "public_id", "sandbox-application"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &applicationSbomExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &applicationSbomExportDataSource{}
+)
+
+// ApplicationSbomExportDataSource is a helper function to simplify the provider implementation.
+func ApplicationSbomExportDataSource() datasource.DataSource {
+	return &applicationSbomExportDataSource{}
+}
+
+// applicationSbomExportDataSource is the data source implementation.
+type applicationSbomExportDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *applicationSbomExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_sbom_export"
+}
+
+// Schema defines the schema for the data source.
+func (d *applicationSbomExportDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Lookup attributes and defaulted options are also set from the SBOM that is exported
+	applicationId := schema.DataSourceOptionalString("Internal ID of the Application - one of `application_id` or `public_id` must be set")
+	applicationId.Computed = true
+	publicId := schema.DataSourceOptionalString("Public ID of the Application - one of `application_id` or `public_id` must be set")
+	publicId.Computed = true
+	format := schema.DataSourceOptionalStringEnum(
+		fmt.Sprintf("Format of the SBOM - defaults to `%s`", common.SBOM_FORMAT_CYCLONEDX_JSON),
+		common.SBOM_FORMAT_CYCLONEDX_JSON,
+		common.SBOM_FORMAT_CYCLONEDX_XML,
+		common.SBOM_FORMAT_SPDX_JSON,
+	)
+	format.Computed = true
+	specVersion := schema.DataSourceOptionalString(
+		fmt.Sprintf("Version of the SBOM specification - defaults to `%s` for CycloneDX and `%s` for SPDX", common.DEFAULT_CYCLONEDX_VERSION, common.DEFAULT_SPDX_VERSION),
+	)
+	specVersion.Computed = true
+
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to export a CycloneDX or SPDX SBOM from the most recent evaluation of an Application at a stage",
+		Attributes: map[string]tfschema.Attribute{
+			"id":             schema.DataSourceComputedString("Internal ID of the evaluation report"),
+			"application_id": applicationId,
+			"public_id":      publicId,
+			"stage": schema.DataSourceRequiredStringEnum(
+				"Stage to export the most recent evaluation for",
+				common.STAGE_DEVELOP,
+				common.STAGE_SOURCE,
+				common.STAGE_BUILD,
+				common.STAGE_STAGE_RELEASE,
+				common.STAGE_RELEASE,
+				common.STAGE_OPERATE,
+			),
+			"format":          format,
+			"spec_version":    specVersion,
+			"scan_id":         schema.DataSourceComputedString("Scan ID of the evaluation"),
+			"evaluation_date": schema.DataSourceComputedString("Date and time of the evaluation"),
+			"content":         schema.DataSourceComputedString("SBOM document content"),
+			"content_hash":    schema.DataSourceComputedString("SHA-256 hash of the SBOM document content"),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *applicationSbomExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.ApplicationSbomExportModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	// Lookup
+	foundApplication := lookupApplication(ctx, &d.BaseDataSource, data.ApplicationId, data.PublicId, &resp.Diagnostics)
+	if foundApplication == nil {
+		return
+	}
+
+	report := latestReport(ctx, &d.BaseDataSource, foundApplication.GetId(), data.Stage.ValueString(), &resp.Diagnostics)
+	if report == nil {
+		return
+	}
+
+	if data.Format.IsNull() {
+		data.Format = types.StringValue(common.SBOM_FORMAT_CYCLONEDX_JSON)
+	}
+	if data.SpecVersion.IsNull() {
+		if data.Format.ValueString() == common.SBOM_FORMAT_SPDX_JSON {
+			data.SpecVersion = types.StringValue(common.DEFAULT_SPDX_VERSION)
+		} else {
+			data.SpecVersion = types.StringValue(common.DEFAULT_CYCLONEDX_VERSION)
+		}
+	}
+
+	content, httpResponse, err := common.ExportSbom(
+		ctx,
+		d.Client,
+		d.Auth,
+		foundApplication.GetId(),
+		report.GetScanId(),
+		data.Format.ValueString(),
+		data.SpecVersion.ValueString(),
+	)
+
+	if err != nil {
+		errors.HandleAPIError(common.ERR_FAILED_READING_SBOM, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+
+	// Map api response to State
+	data.MapFromApi(foundApplication, report, content, sbomContentHash(content))
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package application_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationSbomExportDataSource(t *testing.T) {
+	// Public ID of an Application that has been evaluated at the build stage
+	publicId := os.Getenv("IQ_TEST_EVALUATED_APPLICATION_PUBLIC_ID")
+	if publicId == "" {
+		t.Skip("IQ_TEST_EVALUATED_APPLICATION_PUBLIC_ID not set - skipping")
+	}
+
+	resourceName := "data.sonatypeiq_application_sbom_export.sbom"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// CycloneDX JSON by default
+			{
+				Config: utils_test.ProviderConfig + fmt.Sprintf(`data "sonatypeiq_application_sbom_export" "sbom" {
+					public_id = "%s"
+					stage     = "build"
+				}`, publicId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "application_id"),
+					resource.TestCheckResourceAttr(resourceName, "format", common.SBOM_FORMAT_CYCLONEDX_JSON),
+					resource.TestCheckResourceAttr(resourceName, "spec_version", common.DEFAULT_CYCLONEDX_VERSION),
+					resource.TestCheckResourceAttrSet(resourceName, "scan_id"),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`"bomFormat"\s*:\s*"CycloneDX"`)),
+					resource.TestMatchResourceAttr(resourceName, "content_hash", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+			// CycloneDX XML
+			{
+				Config: utils_test.ProviderConfig + fmt.Sprintf(`data "sonatypeiq_application_sbom_export" "sbom" {
+					public_id = "%s"
+					stage     = "build"
+					format    = "%s"
+				}`, publicId, common.SBOM_FORMAT_CYCLONEDX_XML),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`<bom `)),
+				),
+			},
+			// SPDX
+			{
+				Config: utils_test.ProviderConfig + fmt.Sprintf(`data "sonatypeiq_application_sbom_export" "sbom" {
+					public_id = "%s"
+					stage     = "build"
+					format    = "%s"
+				}`, publicId, common.SBOM_FORMAT_SPDX_JSON),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "spec_version", common.DEFAULT_SPDX_VERSION),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`"spdxVersion"`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,309 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package application
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	sharedrschema "github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// applicationSbomResource is the resource implementation.
+type applicationSbomResource struct {
+	common.BaseResource
+}
+
+// NewApplicationSbomResource is a helper function to simplify the provider implementation.
+func NewApplicationSbomResource() resource.Resource {
+	return &applicationSbomResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *applicationSbomResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_sbom"
+}
+
+// Schema defines the schema for the resource.
+func (r *applicationSbomResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	contentSource := func(description string, other string) schema.StringAttribute {
+		attr := sharedrschema.ResourceOptionalString(description)
+		attr.PlanModifiers = []planmodifier.String{stringplanmodifier.RequiresReplace()}
+		attr.Validators = []validator.String{stringvalidator.ExactlyOneOf(path.MatchRoot(other))}
+		return attr
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Use this resource to import a CycloneDX or SPDX SBOM into SBOM Manager for a version of an Application. The SBOM is removed from SBOM Manager when this resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"id": sharedrschema.ResourceComputedString("Internal ID for Terraform State"),
+			"application_id": sharedrschema.ResourceRequiredStringWithPlanModifier(
+				"Internal ID of the Application",
+				[]planmodifier.String{stringplanmodifier.RequiresReplace()},
+			),
+			"application_version": sharedrschema.ResourceRequiredStringWithPlanModifier(
+				"Version of the Application the SBOM describes",
+				[]planmodifier.String{stringplanmodifier.RequiresReplace()},
+			),
+			"content":      contentSource("SBOM document content (CycloneDX JSON or XML, or SPDX JSON). Exactly one of `content` or `file_path` must be set.", "file_path"),
+			"file_path":    contentSource("Path to a local SBOM document (CycloneDX JSON or XML, or SPDX JSON). Exactly one of `content` or `file_path` must be set.", "content"),
+			"content_hash": sharedrschema.ResourceComputedString("SHA-256 hash of the imported SBOM document - a change to the document replaces the SBOM"),
+			"sbom_id": sharedrschema.ResourceComputedStringWithPlanModifier(
+				"Identifier of the SBOM in Sonatype IQ Server",
+				stringplanmodifier.UseStateForUnknown(),
+			),
+			"last_updated": sharedrschema.ResourceLastUpdated(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "How long to wait for Sonatype IQ Server to process the imported SBOM - defaults to `5m`",
+			}),
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (r *applicationSbomResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan model.ApplicationSbomModelResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Content may not be known until apply
+	if plan.Content.IsUnknown() || plan.FilePath.IsUnknown() {
+		return
+	}
+
+	content, _, err := sbomContent(plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file_path"), "Unable to read SBOM", err.Error())
+		return
+	}
+	contentHash := types.StringValue(sbomContentHash(content))
+	resp.Plan.SetAttribute(ctx, path.Root("content_hash"), contentHash)
+
+	// The SBOM for an Application version cannot be changed in place
+	if !req.State.Raw.IsNull() {
+		var state model.ApplicationSbomModelResource
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if !state.ContentHash.Equal(contentHash) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *applicationSbomResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan model.ApplicationSbomModelResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_PLAN, resp.Diagnostics.Errors()))
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.SBOM_IMPORT_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	r.doImport(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update State
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *applicationSbomResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.ApplicationSbomModelResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_STATE, resp.Diagnostics.Errors()))
+		return
+	}
+
+	// The SBOM content is not compared - only that SBOM Manager still has an SBOM for the Application version
+	_, httpResponse, err := common.ReadSbom(
+		ctx,
+		r.Client,
+		r.Auth,
+		state.ApplicationId.ValueString(),
+		state.ApplicationVersion.ValueString(),
+	)
+
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, fmt.Sprintf("SBOM for Application %s version %s no longer exists - removing from state", state.ApplicationId.ValueString(), state.ApplicationVersion.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		errors.HandleAPIError(
+			"Error reading SBOM",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *applicationSbomResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All changes to the SBOM require replacement - only Terraform managed attributes can change here
+	var plan model.ApplicationSbomModelResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_PLAN, resp.Diagnostics.Errors()))
+		return
+	}
+
+	var state model.ApplicationSbomModelResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_STATE, resp.Diagnostics.Errors()))
+		return
+	}
+
+	plan.ID = state.ID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *applicationSbomResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state model.ApplicationSbomModelResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_STATE, resp.Diagnostics.Errors()))
+		return
+	}
+
+	httpResponse, err := common.DeleteSbom(
+		ctx,
+		r.Client,
+		r.Auth,
+		state.ApplicationId.ValueString(),
+		state.ApplicationVersion.ValueString(),
+	)
+
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, fmt.Sprintf("SBOM for Application %s version %s did not exist", state.ApplicationId.ValueString(), state.ApplicationVersion.ValueString()))
+			return
+		}
+		errors.HandleAPIError(
+			"Error removing SBOM",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+}
+
+func (r *applicationSbomResource) doImport(ctx context.Context, plan *model.ApplicationSbomModelResource, respDiags *diag.Diagnostics) {
+	content, fileName, err := sbomContent(*plan)
+	if err != nil {
+		respDiags.AddAttributeError(path.Root("file_path"), "Unable to read SBOM", err.Error())
+		return
+	}
+
+	sbomId, httpResponse, err := common.ImportSbom(
+		ctx,
+		r.Client,
+		r.Auth,
+		plan.ApplicationId.ValueString(),
+		plan.ApplicationVersion.ValueString(),
+		fileName,
+		content,
+	)
+
+	if err != nil {
+		errors.HandleAPIError(
+			"Error importing SBOM",
+			&err,
+			httpResponse,
+			respDiags,
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s,%s", plan.ApplicationId.ValueString(), plan.ApplicationVersion.ValueString()))
+	plan.SbomId = types.StringValue(sbomId)
+	plan.ContentHash = types.StringValue(sbomContentHash(content))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
+
+// sbomContent returns the SBOM document and a file name for it, from either `content` or `file_path`
+func sbomContent(plan model.ApplicationSbomModelResource) ([]byte, string, error) {
+	if !plan.FilePath.IsNull() {
+		content, err := os.ReadFile(plan.FilePath.ValueString())
+		return content, filepath.Base(plan.FilePath.ValueString()), err
+	}
+
+	content := []byte(plan.Content.ValueString())
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("<")) {
+		return content, "sbom.xml", nil
+	}
+	return content, "sbom.json", nil
+}
+
+func sbomContentHash(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package application_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"terraform-provider-sonatypeiq/internal/provider/application"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+const testApplicationSbom = `{"bomFormat":"CycloneDX","specVersion":"1.5","components":[]}`
+
+// newApplicationSbomStandIn serves just enough of the SBOM Manager API for the lifecycle of a
+// sonatypeiq_application_sbom resource
+func newApplicationSbomStandIn(t *testing.T) (*httptest.Server, *bool) {
+	t.Helper()
+	sbomExists := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/sbom/import", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		sbomExists = true
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"statusUrl":"api/v2/sbom/import/status/ticket-1"}`))
+	})
+	mux.HandleFunc("/api/v2/sbom/import/status/ticket-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"processingStatus":"COMPLETED","sbomId":"sbom-123"}`))
+	})
+	mux.HandleFunc("/api/v2/sbom/applications/app-internal-id/versions/1.2.3", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case !sbomExists:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodDelete:
+			sbomExists = false
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			_, _ = w.Write([]byte(testApplicationSbom))
+		}
+	})
+
+	pollInterval := common.SbomImportPollInterval
+	common.SbomImportPollInterval = time.Millisecond
+	t.Cleanup(func() { common.SbomImportPollInterval = pollInterval })
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &sbomExists
+}
+
+func newConfiguredApplicationSbomResource(t *testing.T, url string) (resource.Resource, resource.SchemaResponse) {
+	t.Helper()
+	configuration := sonatypeiq.NewConfiguration()
+	configuration.Servers = []sonatypeiq.ServerConfiguration{{URL: url}}
+
+	r := application.NewApplicationSbomResource()
+	configureResp := resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: common.SonatypeDataSourceData{Client: sonatypeiq.NewAPIClient(configuration)},
+	}, &configureResp)
+	assert.False(t, configureResp.Diagnostics.HasError())
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	assert.False(t, schemaResp.Diagnostics.HasError())
+	return r, schemaResp
+}
+
+func TestApplicationSbomResourceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	server, sbomExists := newApplicationSbomStandIn(t)
+	r, schemaResp := newConfiguredApplicationSbomResource(t, server.URL)
+
+	// Create
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	assert.False(t, plan.Set(ctx, model.ApplicationSbomModelResource{
+		ID:                 types.StringUnknown(),
+		ApplicationId:      types.StringValue("app-internal-id"),
+		ApplicationVersion: types.StringValue("1.2.3"),
+		Content:            types.StringValue(testApplicationSbom),
+		FilePath:           types.StringNull(),
+		ContentHash:        types.StringUnknown(),
+		SbomId:             types.StringUnknown(),
+		LastUpdated:        types.StringUnknown(),
+		Timeouts:           timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType})},
+	}).HasError())
+
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	assert.False(t, createResp.Diagnostics.HasError(), "%v", createResp.Diagnostics)
+	assert.True(t, *sbomExists)
+
+	var created model.ApplicationSbomModelResource
+	assert.False(t, createResp.State.Get(ctx, &created).HasError())
+	assert.Equal(t, "app-internal-id,1.2.3", created.ID.ValueString())
+	assert.Equal(t, "sbom-123", created.SbomId.ValueString())
+
+	// Read - the SBOM still exists
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), "%v", readResp.Diagnostics)
+	assert.False(t, readResp.State.Raw.IsNull())
+
+	// Delete
+	deleteResp := resource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: createResp.State}, &deleteResp)
+	assert.False(t, deleteResp.Diagnostics.HasError(), "%v", deleteResp.Diagnostics)
+	assert.False(t, *sbomExists)
+
+	// Read - the SBOM was removed outside of Terraform
+	readResp = resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	assert.False(t, readResp.Diagnostics.HasError(), "%v", readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.IsNull())
+}
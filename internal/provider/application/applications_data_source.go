@@ -19,14 +19,20 @@ package application
 import (
 	"context"
 	"fmt"
+	"math"
 	"net/http"
+	"regexp"
+	"slices"
+	"strings"
 	"terraform-provider-sonatypeiq/internal/provider/common"
 	"terraform-provider-sonatypeiq/internal/provider/model"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
 	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
 	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
 )
@@ -55,9 +61,20 @@ func (d *applicationsDataSource) Metadata(_ context.Context, req datasource.Meta
 // Schema defines the schema for the data source.
 func (d *applicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = tfschema.Schema{
-		Description: "Use this data source to get all Applications",
+		Description: "Use this data source to get all Applications, optionally filtered. All filters that are set must match.",
 		Attributes: map[string]tfschema.Attribute{
-			"id": schema.DataSourceComputedString("The ID of this resource."),
+			"id":                        schema.DataSourceComputedString("The ID of this resource."),
+			"organization_id":           schema.DataSourceOptionalString("Only return Applications in the Organization with this Internal ID"),
+			"include_sub_organizations": schema.DataSourceOptionalBool("Also return Applications in Organizations beneath `organization_id` - defaults to `false`"),
+			"public_id_prefix":          schema.DataSourceOptionalString("Only return Applications whose Public ID starts with this prefix"),
+			"public_id_regex":           schema.DataSourceOptionalString("Only return Applications whose Public ID matches this regular expression"),
+			"category_id":               schema.DataSourceOptionalString("Only return Applications in the Application Category with this Internal ID"),
+			"contact_user_name":         schema.DataSourceOptionalString("Only return Applications with this Contact (user name)"),
+			"max_results": schema.DataSourceOptionalInt32WithRange(
+				"Maximum number of Applications to return. The limit is applied after the full list of Applications has been downloaded from Sonatype IQ Server and filtered - it does not reduce the size of the request to the server",
+				1,
+				math.MaxInt32,
+			),
 			"applications": schema.DataSourceComputedListNestedAttribute(
 				"List of Applications",
 				tfschema.NestedAttributeObject{
@@ -94,24 +111,56 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	apiResponse, httpResponse, err := d.Client.ApplicationsAPI.GetApplications(d.AuthContext(ctx)).Execute()
-
-	if err != nil {
-		errors.HandleAPIError(
-			common.ERR_FAILED_READING_APPLICATIONS,
-			&err,
-			httpResponse,
-			&resp.Diagnostics,
-		)
-		return
-	} else if httpResponse.StatusCode != http.StatusOK {
-		errors.AddAPIErrorDiagnostic(&resp.Diagnostics, "read", "Applications", httpResponse, err)
+	filter, ok := newApplicationFilter(data, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
+	// Use the API to filter by Organization where we can
+	organizationIds := []string{""}
+	if !data.OrganizationId.IsNull() {
+		organizationIds = []string{data.OrganizationId.ValueString()}
+		if data.IncludeSubOrganizations.ValueBool() {
+			organizationIds = subOrganizationIds(ctx, &d.BaseDataSource, data.OrganizationId.ValueString(), &resp.Diagnostics)
+			if organizationIds == nil {
+				return
+			}
+		}
+	}
+
+	// Application Categories are only returned when listing all Applications - filter by Organization here instead
+	includeCategories := filter.categoryId != ""
+	if includeCategories && organizationIds[0] != "" {
+		filter.organizationIds = organizationIds
+		organizationIds = []string{""}
+	}
+
+	maxResults := math.MaxInt32
+	if !data.MaxResults.IsNull() {
+		maxResults = int(data.MaxResults.ValueInt32())
+	}
+
+	applications := make([]sonatypeiq.ApiApplicationDTO, 0)
+organizations:
+	for _, organizationId := range organizationIds {
+		apiResponse := readApplications(ctx, &d.BaseDataSource, organizationId, includeCategories, &resp.Diagnostics)
+		if apiResponse == nil {
+			return
+		}
+
+		for _, app := range apiResponse.Applications {
+			if len(applications) >= maxResults {
+				break organizations
+			}
+			if filter.matches(app) {
+				applications = append(applications, app)
+			}
+		}
+	}
+
 	// Assign Response Data to State
 	data.ID = types.StringValue("all-applications")
-	data.MapFromApi(apiResponse)
+	data.MapFromApi(applications)
 
 	// Set state
 	diags := resp.State.Set(ctx, &data)
@@ -120,3 +169,109 @@ func (d *applicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 }
+
+// readApplications returns all Applications, or only those directly in an Organization if organizationId is set.
+// Application Categories can only be included when returning all Applications.
+func readApplications(ctx context.Context, d *common.BaseDataSource, organizationId string, includeCategories bool, respDiags *diag.Diagnostics) *sonatypeiq.ApiApplicationListDTO {
+	var apiResponse *sonatypeiq.ApiApplicationListDTO
+	var httpResponse *http.Response
+	var err error
+	if organizationId == "" {
+		apiResponse, httpResponse, err = d.Client.ApplicationsAPI.GetApplications(d.AuthContext(ctx)).IncludeCategories(includeCategories).Execute()
+	} else {
+		apiResponse, httpResponse, err = d.Client.ApplicationsAPI.GetApplicationsByOrganizationId(d.AuthContext(ctx), organizationId).Execute()
+	}
+
+	if err != nil {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_APPLICATIONS,
+			&err,
+			httpResponse,
+			respDiags,
+		)
+		return nil
+	} else if httpResponse.StatusCode != http.StatusOK {
+		errors.AddAPIErrorDiagnostic(respDiags, "read", "Applications", httpResponse, err)
+		return nil
+	}
+
+	return apiResponse
+}
+
+// subOrganizationIds returns the ID of an Organization and the IDs of all Organizations beneath it
+func subOrganizationIds(ctx context.Context, d *common.BaseDataSource, organizationId string, respDiags *diag.Diagnostics) []string {
+	organizationIds, httpResponse, err := common.ReadOrganizationDescendants(ctx, d.Client, d.Auth, organizationId)
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_ORGANIZATIONS,
+			&err,
+			httpResponse,
+			respDiags,
+		)
+		return nil
+	}
+
+	if len(organizationIds) == 0 {
+		errors.AddValidationDiagnostic(respDiags, "organization_id", fmt.Sprintf(common.ERR_ORGANIZATION_DID_NOT_EXIST, organizationId))
+		return nil
+	}
+	return organizationIds
+}
+
+// applicationFilter holds the filters for the Applications data source that the API cannot apply
+type applicationFilter struct {
+	organizationIds []string
+	publicIdPrefix  string
+	publicIdRegex   *regexp.Regexp
+	categoryId      string
+	contactUserName string
+}
+
+func newApplicationFilter(data model.ApplicationsModel, respDiags *diag.Diagnostics) (applicationFilter, bool) {
+	filter := applicationFilter{
+		publicIdPrefix:  data.PublicIdPrefix.ValueString(),
+		categoryId:      data.CategoryId.ValueString(),
+		contactUserName: data.ContactUserName.ValueString(),
+	}
+
+	if data.IncludeSubOrganizations.ValueBool() && data.OrganizationId.IsNull() {
+		errors.AddValidationDiagnostic(respDiags, "include_sub_organizations", "`organization_id` must be set to include sub-Organizations")
+		return filter, false
+	}
+
+	if !data.PublicIdRegex.IsNull() {
+		publicIdRegex, err := regexp.Compile(data.PublicIdRegex.ValueString())
+		if err != nil {
+			errors.AddValidationDiagnostic(respDiags, "public_id_regex", fmt.Sprintf("Invalid regular expression: %v", err))
+			return filter, false
+		}
+		filter.publicIdRegex = publicIdRegex
+	}
+
+	return filter, true
+}
+
+func (f applicationFilter) matches(app sonatypeiq.ApiApplicationDTO) bool {
+	if len(f.organizationIds) > 0 && !slices.Contains(f.organizationIds, app.GetOrganizationId()) {
+		return false
+	}
+	if f.publicIdPrefix != "" && !strings.HasPrefix(app.GetPublicId(), f.publicIdPrefix) {
+		return false
+	}
+	if f.publicIdRegex != nil && !f.publicIdRegex.MatchString(app.GetPublicId()) {
+		return false
+	}
+	if f.contactUserName != "" && !strings.EqualFold(app.GetContactUserName(), f.contactUserName) {
+		return false
+	}
+	if f.categoryId != "" {
+		for _, tag := range app.ApplicationTags {
+			if tag.GetTagId() == f.categoryId {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
@@ -17,14 +17,22 @@
 package application_test
 
 import (
+	"fmt"
 	"testing"
 
+	"terraform-provider-sonatypeiq/internal/provider/application"
+	"terraform-provider-sonatypeiq/internal/provider/model"
 	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccApplicationsDataSource(t *testing.T) {
+	appName := `TFACC` + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -37,6 +45,139 @@ func TestAccApplicationsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.sonatypeiq_applications.apps", "applications.#"),
 				),
 			},
+			// Filtered Read testing
+			{
+				Config: testAccApplicationsDataSourceFiltered(appName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonatypeiq_applications.by_org", "applications.#", "2"),
+					resource.TestCheckResourceAttr("data.sonatypeiq_applications.by_org_recursive", "applications.#", "3"),
+					resource.TestCheckResourceAttr("data.sonatypeiq_applications.by_prefix", "applications.#", "1"),
+					resource.TestCheckResourceAttr("data.sonatypeiq_applications.by_prefix", "applications.0.public_id", appName+"-b"),
+					resource.TestCheckResourceAttr("data.sonatypeiq_applications.by_regex", "applications.#", "2"),
+					resource.TestCheckResourceAttr("data.sonatypeiq_applications.limited", "applications.#", "1"),
+				),
+			},
 		},
 	})
 }
+
+func testAccApplicationsDataSourceFiltered(name string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+data "sonatypeiq_organization" "sandbox" {
+  name = "Sandbox Organization"
+}
+
+resource "sonatypeiq_organization" "org" {
+  name                   = "%s"
+  parent_organization_id = data.sonatypeiq_organization.sandbox.id
+}
+
+resource "sonatypeiq_organization" "sub_org" {
+  name                   = "%s-sub"
+  parent_organization_id = sonatypeiq_organization.org.id
+}
+
+resource "sonatypeiq_application" "a" {
+  name            = "%s-a"
+  public_id       = "%s-a"
+  organization_id = sonatypeiq_organization.org.id
+}
+
+resource "sonatypeiq_application" "b" {
+  name            = "%s-b"
+  public_id       = "%s-b"
+  organization_id = sonatypeiq_organization.org.id
+}
+
+resource "sonatypeiq_application" "c" {
+  name            = "%s-c"
+  public_id       = "%s-c"
+  organization_id = sonatypeiq_organization.sub_org.id
+}
+
+data "sonatypeiq_applications" "by_org" {
+  organization_id = sonatypeiq_organization.org.id
+  depends_on      = [sonatypeiq_application.a, sonatypeiq_application.b, sonatypeiq_application.c]
+}
+
+data "sonatypeiq_applications" "by_org_recursive" {
+  organization_id           = sonatypeiq_organization.org.id
+  include_sub_organizations = true
+  depends_on                = [sonatypeiq_application.a, sonatypeiq_application.b, sonatypeiq_application.c]
+}
+
+data "sonatypeiq_applications" "by_prefix" {
+  public_id_prefix = "%s-b"
+  depends_on       = [sonatypeiq_application.a, sonatypeiq_application.b, sonatypeiq_application.c]
+}
+
+data "sonatypeiq_applications" "by_regex" {
+  organization_id           = sonatypeiq_organization.org.id
+  include_sub_organizations = true
+  public_id_regex           = "^%s-[ac]$"
+  depends_on                = [sonatypeiq_application.a, sonatypeiq_application.b, sonatypeiq_application.c]
+}
+
+data "sonatypeiq_applications" "limited" {
+  organization_id = sonatypeiq_organization.org.id
+  max_results     = 1
+  depends_on      = [sonatypeiq_application.a, sonatypeiq_application.b, sonatypeiq_application.c]
+}
+`, name, name, name, name, name, name, name, name, name, name)
+}
+
+func TestApplicationFilterMatches(t *testing.T) {
+	app := sonatypeiq.ApiApplicationDTO{
+		PublicId:        sonatypeiq.PtrString("payments-api"),
+		ContactUserName: sonatypeiq.PtrString("jdoe"),
+		ApplicationTags: []sonatypeiq.ApiApplicationTagDTO{
+			{TagId: sonatypeiq.PtrString("category-1")},
+			{TagId: sonatypeiq.PtrString("category-2")},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter model.ApplicationsModel
+		want   bool
+	}{
+		{"no filters", model.ApplicationsModel{}, true},
+		{"public id prefix", model.ApplicationsModel{PublicIdPrefix: types.StringValue("payments-")}, true},
+		{"other public id prefix", model.ApplicationsModel{PublicIdPrefix: types.StringValue("api")}, false},
+		{"public id regex", model.ApplicationsModel{PublicIdRegex: types.StringValue(`-api$`)}, true},
+		{"other public id regex", model.ApplicationsModel{PublicIdRegex: types.StringValue(`^api-`)}, false},
+		{"category", model.ApplicationsModel{CategoryId: types.StringValue("category-2")}, true},
+		{"other category", model.ApplicationsModel{CategoryId: types.StringValue("category-3")}, false},
+		{"contact", model.ApplicationsModel{ContactUserName: types.StringValue("JDoe")}, true},
+		{"other contact", model.ApplicationsModel{ContactUserName: types.StringValue("asmith")}, false},
+		{"all filters", model.ApplicationsModel{
+			PublicIdPrefix:  types.StringValue("payments"),
+			PublicIdRegex:   types.StringValue(`api`),
+			CategoryId:      types.StringValue("category-1"),
+			ContactUserName: types.StringValue("jdoe"),
+		}, true},
+		{"all but one filter", model.ApplicationsModel{
+			PublicIdPrefix:  types.StringValue("payments"),
+			PublicIdRegex:   types.StringValue(`api`),
+			CategoryId:      types.StringValue("category-1"),
+			ContactUserName: types.StringValue("asmith"),
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, diags := application.ApplicationFilterMatches(tt.filter, app)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.want, matches)
+		})
+	}
+
+	// Applications returned without Application Categories never match a category
+	matches, _ := application.ApplicationFilterMatches(model.ApplicationsModel{CategoryId: types.StringValue("category-1")}, sonatypeiq.ApiApplicationDTO{})
+	assert.False(t, matches)
+
+	// Invalid filters
+	_, diags := application.ApplicationFilterMatches(model.ApplicationsModel{PublicIdRegex: types.StringValue(`(`)}, app)
+	assert.True(t, diags.HasError())
+	_, diags = application.ApplicationFilterMatches(model.ApplicationsModel{IncludeSubOrganizations: types.BoolValue(true)}, app)
+	assert.True(t, diags.HasError())
+}
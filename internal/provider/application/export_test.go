@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package application
+
+import (
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// ApplicationFilterMatches builds the filter for the sonatypeiq_applications data source from data and returns
+// whether app matches it
+func ApplicationFilterMatches(data model.ApplicationsModel, app sonatypeiq.ApiApplicationDTO) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	filter, ok := newApplicationFilter(data, &diags)
+	return ok && filter.matches(app), diags
+}
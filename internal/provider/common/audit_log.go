@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AuditLogEntry is a single event from the Sonatype IQ Server audit log
+type AuditLogEntry struct {
+	Timestamp string
+	Actor     string
+	Action    string
+	Domain    string
+	Object    string
+	Raw       string
+}
+
+// Keys used for each part of an audit log entry - the first key present wins
+var (
+	auditLogTimestampKeys = []string{"timestamp"}
+	auditLogActorKeys     = []string{"initiator", "user", "username"}
+	auditLogActionKeys    = []string{"type", "action"}
+	auditLogDomainKeys    = []string{"domain"}
+	auditLogObjectKeys    = []string{"context", "object", "name"}
+)
+
+// Formats an audit log timestamp may be in, other than milliseconds since the epoch
+var auditLogTimestampLayouts = []string{
+	"2006-01-02T15:04:05.000-0700",
+	time.RFC3339Nano,
+}
+
+// ParseAuditLog parses the audit log as returned by Sonatype IQ Server - one JSON event per line - and returns the
+// entries oldest first. Lines that are not JSON are returned with only Raw set so that no events are lost, and
+// stay after the entry that preceded them.
+func ParseAuditLog(content []byte) ([]AuditLogEntry, error) {
+	entries := make([]AuditLogEntry, 0)
+	occurred := make([]time.Time, 0)
+	var previous time.Time
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		entry := AuditLogEntry{Raw: line}
+		var event map[string]any
+		if err := json.Unmarshal([]byte(line), &event); err == nil {
+			entry.Timestamp = auditLogValue(event, auditLogTimestampKeys)
+			entry.Actor = auditLogValue(event, auditLogActorKeys)
+			entry.Action = auditLogValue(event, auditLogActionKeys)
+			entry.Domain = auditLogValue(event, auditLogDomainKeys)
+			entry.Object = auditLogValue(event, auditLogObjectKeys)
+		}
+		if at, ok := parseAuditLogTimestamp(entry.Timestamp); ok {
+			previous = at
+		}
+		entries = append(entries, entry)
+		occurred = append(occurred, previous)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	order := make([]int, len(entries))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return occurred[order[i]].Before(occurred[order[j]])
+	})
+
+	sorted := make([]AuditLogEntry, 0, len(entries))
+	for _, i := range order {
+		sorted = append(sorted, entries[i])
+	}
+	return sorted, nil
+}
+
+func parseAuditLogTimestamp(timestamp string) (time.Time, bool) {
+	if timestamp == "" {
+		return time.Time{}, false
+	}
+	if millis, err := strconv.ParseFloat(timestamp, 64); err == nil {
+		return time.UnixMilli(int64(millis)), true
+	}
+	for _, layout := range auditLogTimestampLayouts {
+		if at, err := time.Parse(layout, timestamp); err == nil {
+			return at, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func auditLogValue(event map[string]any, keys []string) string {
+	for _, key := range keys {
+		switch v := event[key].(type) {
+		case nil:
+			continue
+		case string:
+			return v
+		case float64:
+			// Avoid exponent notation so that millisecond timestamps keep their precision
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		case map[string]any, []any:
+			encoded, _ := json.Marshal(v)
+			return string(encoded)
+		default:
+			return fmt.Sprintf("%v", v)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	"strings"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAuditLog(t *testing.T) {
+	content := `{"timestamp":"2025-01-02T10:11:12.000+0000","initiator":"admin","domain":"governance.application","type":"create","context":"sandbox-application"}
+
+{"timestamp":"2025-01-02T10:12:00.000+0000","user":"jdoe","action":"login","object":{"realm":"Internal"}}
+not json
+`
+	entries, err := common.ParseAuditLog([]byte(content))
+	assert.NoError(t, err)
+
+	assert.Len(t, entries, 3)
+	assert.Equal(t, common.AuditLogEntry{
+		Timestamp: "2025-01-02T10:11:12.000+0000",
+		Actor:     "admin",
+		Action:    "create",
+		Domain:    "governance.application",
+		Object:    "sandbox-application",
+		Raw:       `{"timestamp":"2025-01-02T10:11:12.000+0000","initiator":"admin","domain":"governance.application","type":"create","context":"sandbox-application"}`,
+	}, entries[0])
+	assert.Equal(t, "jdoe", entries[1].Actor)
+	assert.Equal(t, "login", entries[1].Action)
+	assert.Equal(t, `{"realm":"Internal"}`, entries[1].Object)
+	assert.Equal(t, "", entries[1].Domain)
+	assert.Equal(t, common.AuditLogEntry{Raw: "not json"}, entries[2])
+}
+
+func TestParseAuditLogOrder(t *testing.T) {
+	content := `{"timestamp":"2025-01-02T10:12:00.000+0000","type":"second"}
+{"timestamp":"2025-01-02T11:11:12.000+0100","type":"first"}
+not json
+{"timestamp":"2025-01-02T10:15:00Z","type":"third"}
+{"timestamp":1735813200000,"type":"fourth"}
+{"type":"no timestamp"}
+`
+	entries, err := common.ParseAuditLog([]byte(content))
+	assert.NoError(t, err)
+
+	actions := make([]string, 0)
+	for _, entry := range entries {
+		actions = append(actions, entry.Action)
+	}
+	assert.Equal(t, []string{"first", "", "second", "third", "fourth", "no timestamp"}, actions)
+	assert.Equal(t, "not json", entries[1].Raw)
+	assert.Equal(t, "1735813200000", entries[4].Timestamp)
+}
+
+func TestParseAuditLogLineTooLong(t *testing.T) {
+	content := `{"type":"first"}` + "\n" + strings.Repeat("x", 11*1024*1024) + "\n" + `{"type":"last"}` + "\n"
+
+	_, err := common.ParseAuditLog([]byte(content))
+	assert.Error(t, err)
+}
@@ -26,6 +26,7 @@ const (
 	MEMBER_TYPE_GROUP                 string = "group"
 	MEMBER_TYPE_USER                  string = "user"
 	OWNER_TYPE_APPLICATION            string = "application"
+	OWNER_TYPE_GLOBAL                 string = "global"
 	OWNER_TYPE_ORGANIZATION           string = "organization"
 	ROOT_ORGANIZATION_ID              string = "ROOT_ORGANIZATION_ID"
 	SAML_DEFAULT_EMAIL_ATTRIBUTE      string = "email"
@@ -37,12 +38,21 @@ const (
 	SCM_PROVIDER_BITBUCKET            string = "bitbucket"
 	SCM_PROVIDER_GITHUB               string = "github"
 	SCM_PROVIDER_GITLAB               string = "gitlab"
+	STAGE_BUILD                       string = "build"
+	STAGE_DEVELOP                     string = "develop"
+	STAGE_OPERATE                     string = "operate"
+	STAGE_RELEASE                     string = "release"
+	STAGE_SOURCE                      string = "source"
+	STAGE_STAGE_RELEASE               string = "stage-release"
 	STATE_ID_CROWD_CONFIGURATION      string = "system-crowd-configuration"
+	STATE_ID_FEATURE_CONFIGURATION    string = "system-feature-configuration"
 	STATE_ID_MAIL_CONFIGURATION       string = "system-mail-configuration"
 	STATE_ID_IQ_PRODUCT_LICENSE       string = "system-product-license"
+	STATE_ID_JIRA_CONFIGURATION       string = "system-jira-configuration"
 	STATE_ID_PROXY_CONFIGURATION      string = "system-proxy-configuration"
 	STATE_ID_SAML_CONFIGURATION       string = "system-saml-configuration"
 	STATE_ID_SYSTEM_CONFIGURATION     string = "system-property-configuration"
+	STATE_ID_USER_TOKEN_CONFIGURATION string = "system-user-token-configuration"
 	USER_ID_FORMAT                    string = "user-%s-%s"
 	USER_REALM_INTERNAL               string = "Internal"
 	USER_REALM_SAML                   string = "SAML"
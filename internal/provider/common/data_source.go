@@ -31,9 +31,11 @@ var (
 )
 
 type BaseDataSource struct {
-	Auth      sonatypeiq.BasicAuth
-	Client    *sonatypeiq.APIClient
-	IqVersion int32
+	Auth          sonatypeiq.BasicAuth
+	BaseUrl       string
+	Client        *sonatypeiq.APIClient
+	IqVersion     int32
+	ServerVersion ServerVersion
 }
 
 // Configure implements datasource.DataSourceWithConfigure.
@@ -53,8 +55,10 @@ func (d *BaseDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 	}
 
 	d.Auth = config.Auth
+	d.BaseUrl = config.BaseUrl
 	d.Client = config.Client
 	d.IqVersion = config.IqVersion
+	d.ServerVersion = config.ServerVersion
 }
 
 // AuthContext returns a new context with authentication set up for API calls
@@ -23,6 +23,7 @@ const (
 	ERR_APPLICATION_DID_NOT_EXIST                     string = "Application did not exist: %s"
 	ERR_APPLICATION_CATEGORY_FOR_ORG_DID_NOT_EXIST    string = "Application Category for Organization did not exist: %s"
 	ERR_CROWD_CONFIGURATION_DID_NOT_EXIST             string = "Crowd configuration did not exist"
+	ERR_JIRA_CONFIGURATION_DID_NOT_EXIST              string = "Jira configuration did not exist"
 	ERR_MAIL_CONFIGURATION_DID_NOT_EXIST              string = "Mail configuration did not exist"
 	ERR_PROXY_CONFIGURATION_DID_NOT_EXIST             string = "Proxy Server configuration did not exist"
 	ERR_SAML_CONFIGURATION_DID_NOT_EXIST              string = "SAML configuration did not exist"
@@ -33,19 +34,42 @@ const (
 	ERR_FAILED_READING_APPLICATION                    string = "Unable to read Application"
 	ERR_FAILED_READING_APPLICATIONS                   string = "Unable to read Applications"
 	ERR_FAILED_READING_APPLICATION_CATEGORIES_FOR_ORG string = "Unable to read IQ Application Categories for Organization"
+	ERR_FAILED_READING_AUDIT_LOG                      string = "Unable to read Audit Log"
+	ERR_FAILED_READING_COMPONENT_DETAILS              string = "Unable to read Component details"
 	ERR_FAILED_READING_CROWD_CONFIGURATION            string = "Unable to read Crowd configuration"
+	ERR_FAILED_READING_FEATURES                       string = "Unable to read Features"
+	ERR_FAILED_READING_LICENSES                       string = "Unable to read Licenses"
+	ERR_FAILED_READING_LICENSE_THREAT_GROUPS          string = "Unable to read License Threat Groups"
+	ERR_FAILED_READING_JIRA_CONFIGURATION             string = "Unable to read Jira configuration"
 	ERR_FAILED_READING_MAIL_CONFIGURATION             string = "Unable to read Mail configuration"
 	ERR_FAILED_READING_ORGANIZATION                   string = "Unable to read Organization"
 	ERR_FAILED_READING_ORGANIZATIONS                  string = "Unable to read Organizations"
+	ERR_FAILED_READING_POLICIES                       string = "Unable to read Policies"
+	ERR_FAILED_READING_POLICY_ACTIONS                 string = "Unable to read stage actions for Policies owned by %s"
+	ERR_FAILED_READING_POLICY_APPLICATION_CATEGORIES  string = "Unable to read Application Categories applied to Policy"
+	ERR_FAILED_READING_POLICY_BUNDLE                  string = "Unable to export Policies"
+	ERR_FAILED_READING_POLICY_WAIVERS                 string = "Unable to read Policy Waivers"
+	ERR_FAILED_READING_POLICY_VIOLATIONS              string = "Unable to read Policy Violations"
+	ERR_FAILED_READING_PRODUCT_LICENSE                string = "Unable to read Product License"
 	ERR_FAILED_READING_PROXY_CONFIGURATION            string = "Unable to read Proxy Server configuration"
+	ERR_FAILED_READING_REPORT_HISTORY                 string = "Unable to read Application Report history"
+	ERR_FAILED_READING_SBOM                           string = "Unable to export SBOM"
 	ERR_FAILED_READING_SCM_CONFIGURATION              string = "Unable to read Source Control configuration"
 	ERR_FAILED_READING_ROLE_BY_ID                     string = "Unable to read Role by ID"
+	ERR_FAILED_READING_EFFECTIVE_PERMISSIONS          string = "Unable to determine effective Permissions"
+	ERR_FAILED_READING_ROLE_MEMBERSHIPS               string = "Unable to read Role Memberships"
 	ERR_FAILED_READING_ROLES                          string = "Unable to read Roles"
 	ERR_FAILED_READING_SAML_METADATA                  string = "Unable to read SAML Metadata"
 	ERR_FAILED_READING_SYSTEM_CONFIG                  string = "Unable to read System Configuration"
+	ERR_FAILED_READING_USER_TOKEN_CONFIGURATION       string = "Unable to read User Token configuration"
 	ERR_FAILED_READING_USER_AT_REALM                  string = "Unable to read User '%s' for Realm '%s'"
+	ERR_FAILED_READING_USERS                          string = "Unable to read Users"
+	ERR_FAILED_READING_VULNERABILITY                  string = "Unable to read Vulnerability details"
 	ERR_ORGANIZATION_DID_NOT_EXIST                    string = "Organization did not exist: %s"
+	ERR_POLICY_DID_NOT_EXIST                          string = "Policy did not exist: %s"
 	ERR_ROLE_DID_NOT_EXIST                            string = "Role did not exist: %s"
 	ERR_SOURCE_CONTROL_CONFIGURATION_DID_NOT_EXIST    string = "Source Control configuration did not exist: %s"
 	ERR_USER_DID_NOT_EXIST                            string = "User did not exist: %s"
+
+	ERR_INTERNAL_ENDPOINT_NOT_SUPPORTED string = "%s are read from an internal Sonatype IQ Server endpoint (%s) that is not available on this version of Sonatype IQ Server"
 )
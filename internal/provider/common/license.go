@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// License is a license known to Sonatype IQ Server.
+type License struct {
+	Id   string `json:"id"`
+	Name string `json:"shortDisplayName"`
+}
+
+// LicenseThreatGroup is a License Threat Group defined on an Organization, with the IDs of the licenses in it.
+type LicenseThreatGroup struct {
+	Id             string `json:"id"`
+	Name           string `json:"name"`
+	ThreatLevel    int32  `json:"threatLevel"`
+	ThreatCategory string `json:"threatLevelCategory"`
+	OwnerId        string `json:"ownerId"`
+	LicenseIds     []string
+}
+
+type licenseThreatGroupLicense struct {
+	LicenseId string `json:"licenseId"`
+}
+
+// ReadLicenses returns all licenses known to Sonatype IQ Server, ordered by ID.
+func ReadLicenses(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth) ([]License, *http.Response, error) {
+	licenses := make([]License, 0)
+	httpResponse, err := ExecuteRestJsonRequest(ctx, client, auth, http.MethodGet, fmt.Sprintf(REST_PATH_LICENSES, ROOT_ORGANIZATION_ID), nil, &licenses)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	sort.Slice(licenses, func(i, j int) bool { return licenses[i].Id < licenses[j].Id })
+	return licenses, httpResponse, nil
+}
+
+// ReadLicenseThreatGroups returns the License Threat Groups for an Organization, ordered by threat level (highest
+// first) then name, each with the IDs of the licenses it contains.
+func ReadLicenseThreatGroups(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth, organizationId string) ([]LicenseThreatGroup, *http.Response, error) {
+	groups := make([]LicenseThreatGroup, 0)
+	httpResponse, err := ExecuteRestJsonRequest(ctx, client, auth, http.MethodGet, fmt.Sprintf(REST_PATH_LICENSE_THREAT_GROUPS, organizationId), nil, &groups)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	for i := range groups {
+		groupLicenses := make([]licenseThreatGroupLicense, 0)
+		httpResponse, err = ExecuteRestJsonRequest(ctx, client, auth, http.MethodGet, fmt.Sprintf(REST_PATH_LICENSE_THREAT_GROUP_LICENSES, organizationId, groups[i].Id), nil, &groupLicenses)
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		groups[i].LicenseIds = make([]string, 0, len(groupLicenses))
+		for _, groupLicense := range groupLicenses {
+			groups[i].LicenseIds = append(groups[i].LicenseIds, groupLicense.LicenseId)
+		}
+		sort.Strings(groups[i].LicenseIds)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].ThreatLevel != groups[j].ThreatLevel {
+			return groups[i].ThreatLevel > groups[j].ThreatLevel
+		}
+		return groups[i].Name < groups[j].Name
+	})
+	return groups, httpResponse, nil
+}
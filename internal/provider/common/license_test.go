@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"testing"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadLicenses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf(common.REST_PATH_LICENSES, common.ROOT_ORGANIZATION_ID), r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":"MIT","shortDisplayName":"MIT"},{"id":"Apache-2.0","shortDisplayName":"Apache-2.0","longDisplayName":"Apache License, Version 2.0"}]`))
+	}))
+	defer server.Close()
+
+	licenses, _, err := common.ReadLicenses(context.Background(), newTestClient(server.URL), sonatypeiq.BasicAuth{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []common.License{{Id: "Apache-2.0", Name: "Apache-2.0"}, {Id: "MIT", Name: "MIT"}}, licenses)
+}
+
+func TestReadLicenseThreatGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case fmt.Sprintf(common.REST_PATH_LICENSE_THREAT_GROUPS, "org-1"):
+			_, _ = w.Write([]byte(`[{"id":"ltg-1","name":"Liberal","threatLevel":0,"ownerId":"org-1"},{"id":"ltg-2","name":"Copyleft","threatLevel":10,"threatLevelCategory":"copyleft","ownerId":"org-1"}]`))
+		case fmt.Sprintf(common.REST_PATH_LICENSE_THREAT_GROUP_LICENSES, "org-1", "ltg-1"):
+			_, _ = w.Write([]byte(`[{"id":"a","licenseId":"MIT","licenseThreatGroupId":"ltg-1"},{"id":"b","licenseId":"Apache-2.0","licenseThreatGroupId":"ltg-1"}]`))
+		case fmt.Sprintf(common.REST_PATH_LICENSE_THREAT_GROUP_LICENSES, "org-1", "ltg-2"):
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	groups, _, err := common.ReadLicenseThreatGroups(context.Background(), newTestClient(server.URL), sonatypeiq.BasicAuth{}, "org-1")
+
+	assert.NoError(t, err)
+	assert.Len(t, groups, 2)
+	assert.Equal(t, "Copyleft", groups[0].Name)
+	assert.Equal(t, int32(10), groups[0].ThreatLevel)
+	assert.Equal(t, "copyleft", groups[0].ThreatCategory)
+	assert.Empty(t, groups[0].LicenseIds)
+	assert.Equal(t, "Liberal", groups[1].Name)
+	assert.Equal(t, []string{"Apache-2.0", "MIT"}, groups[1].LicenseIds)
+}
+
+func TestReadLicenseThreatGroupsOrganizationNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	groups, httpResponse, err := common.ReadLicenseThreatGroups(context.Background(), newTestClient(server.URL), sonatypeiq.BasicAuth{}, "missing")
+
+	assert.Error(t, err)
+	assert.Nil(t, groups)
+	assert.Equal(t, http.StatusNotFound, httpResponse.StatusCode)
+}
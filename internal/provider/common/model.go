@@ -31,10 +31,23 @@ import (
 )
 
 type SonatypeDataSourceData struct {
-	Auth      sonatypeiq.BasicAuth
-	BaseUrl   string
-	Client    *sonatypeiq.APIClient
-	IqVersion int32
+	Auth          sonatypeiq.BasicAuth
+	BaseUrl       string
+	Client        *sonatypeiq.APIClient
+	IqVersion     int32
+	ServerVersion ServerVersion
+}
+
+// ServerVersion is the full version of Sonatype IQ Server - for example 1.201.0-02
+type ServerVersion struct {
+	Major int32
+	Minor int32
+	Patch int32
+	Build int32
+}
+
+func (v ServerVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d-%02d", v.Major, v.Minor, v.Patch, v.Build)
 }
 
 func (p *SonatypeDataSourceData) CheckWritableAndGetVersion(ctx context.Context, respDiags *diag.Diagnostics) {
@@ -54,8 +67,9 @@ func (p *SonatypeDataSourceData) CheckWritableAndGetVersion(ctx context.Context,
 	}
 
 	if httpResponse.StatusCode == http.StatusOK {
-		p.IqVersion = ParseServerHeaderToVersion(httpResponse.Header.Get("server"))
-		tflog.Debug(ctx, fmt.Sprintf("Server Header: %v", p.IqVersion))
+		p.ServerVersion = ParseServerHeader(httpResponse.Header.Get("server"))
+		p.IqVersion = p.ServerVersion.Minor
+		tflog.Debug(ctx, fmt.Sprintf("Server Header: %v", p.ServerVersion))
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Determined Sonatype IQ Server to be version %v", p.IqVersion))
@@ -65,18 +79,29 @@ func (p *SonatypeDataSourceData) CheckWritableAndGetVersion(ctx context.Context,
 var nxiqServerVersionExp = regexp.MustCompile(`^NEXUSIQ\/(?P<MAJOR>\d+)\.(?P<MINOR>\d+)\.(?P<PATCH>\d+)\-(?P<BUILD>\d+)$`)
 
 func ParseServerHeaderToVersion(headerStr string) int32 {
+	return ParseServerHeader(headerStr).Minor
+}
+
+// ParseServerHeader returns the full version from the Server header - an unrecognised header gives a zero version
+func ParseServerHeader(headerStr string) ServerVersion {
 	match := FindAllGroups(nxiqServerVersionExp, strings.ToUpper(headerStr))
-	var iqVersion int32
+	var serverVersion ServerVersion
 	if match == nil {
-		return iqVersion
+		return serverVersion
 	}
 	for k, v := range match {
 		switch k {
+		case "MAJOR":
+			serverVersion.Major = GetStringAsInt32(v)
 		case "MINOR":
-			iqVersion = GetStringAsInt32(v)
+			serverVersion.Minor = GetStringAsInt32(v)
+		case "PATCH":
+			serverVersion.Patch = GetStringAsInt32(v)
+		case "BUILD":
+			serverVersion.Build = GetStringAsInt32(v)
 		}
 	}
-	return iqVersion
+	return serverVersion
 }
 
 func FindAllGroups(re *regexp.Regexp, s string) map[string]string {
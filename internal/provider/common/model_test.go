@@ -41,3 +41,11 @@ func TestModelServerHeaderVersionParse(t *testing.T) {
 		assert.Equal(t, tc.expectedVersion, sv)
 	}
 }
+
+func TestModelServerHeaderFullVersionParse(t *testing.T) {
+	sv := common.ParseServerHeader("NexusIQ/1.201.0-02")
+	assert.Equal(t, common.ServerVersion{Major: 1, Minor: 201, Patch: 0, Build: 2}, sv)
+	assert.Equal(t, "1.201.0-02", sv.String())
+
+	assert.Equal(t, common.ServerVersion{}, common.ParseServerHeader("Jetty(9.4)"))
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"net/http"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// ReadOrganizationAncestry returns the ID of an Organization followed by the IDs of each of its parent
+// Organizations, ending with the Root Organization.
+func ReadOrganizationAncestry(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth, organizationId string) ([]string, *http.Response, error) {
+	var httpResponse *http.Response
+	organizationIds := make([]string, 0)
+	seen := make(map[string]bool)
+	for organizationId != "" && !seen[organizationId] {
+		seen[organizationId] = true
+		organizationIds = append(organizationIds, organizationId)
+		if organizationId == ROOT_ORGANIZATION_ID {
+			break
+		}
+
+		var apiResponse *sonatypeiq.ApiOrganizationDTO
+		var err error
+		apiResponse, httpResponse, err = client.OrganizationsAPI.GetOrganization(WithAuth(ctx, auth), organizationId).Execute()
+		if err != nil {
+			return nil, httpResponse, err
+		}
+		organizationId = apiResponse.GetParentOrganizationId()
+	}
+	return organizationIds, httpResponse, nil
+}
+
+// ReadOrganizationDescendants returns the ID of an Organization followed by the IDs of all Organizations beneath
+// it, breadth first. An empty list is returned if the Organization does not exist.
+func ReadOrganizationDescendants(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth, organizationId string) ([]string, *http.Response, error) {
+	apiResponse, httpResponse, err := client.OrganizationsAPI.GetOrganizations(WithAuth(ctx, auth)).Execute()
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	found := false
+	children := make(map[string][]string)
+	for _, org := range apiResponse.Organizations {
+		found = found || org.GetId() == organizationId
+		children[org.GetParentOrganizationId()] = append(children[org.GetParentOrganizationId()], org.GetId())
+	}
+	if !found {
+		return []string{}, httpResponse, nil
+	}
+
+	organizationIds := []string{organizationId}
+	for i := 0; i < len(organizationIds); i++ {
+		organizationIds = append(organizationIds, children[organizationIds[i]]...)
+	}
+	return organizationIds, httpResponse, nil
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"testing"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOrganizationAncestry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v2/organizations/child":
+			_, _ = w.Write([]byte(`{"id":"child","name":"Child","parentOrganizationId":"parent"}`))
+		case "/api/v2/organizations/parent":
+			_, _ = w.Write([]byte(`{"id":"parent","name":"Parent","parentOrganizationId":"ROOT_ORGANIZATION_ID"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	organizationIds, _, err := common.ReadOrganizationAncestry(context.Background(), newTestClient(server.URL), sonatypeiq.BasicAuth{}, "child")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"child", "parent", common.ROOT_ORGANIZATION_ID}, organizationIds)
+}
+
+func TestReadOrganizationAncestryRoot(t *testing.T) {
+	organizationIds, _, err := common.ReadOrganizationAncestry(context.Background(), newTestClient("http://127.0.0.1:0"), sonatypeiq.BasicAuth{}, common.ROOT_ORGANIZATION_ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{common.ROOT_ORGANIZATION_ID}, organizationIds)
+}
+
+func TestReadOrganizationAncestryNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	organizationIds, httpResponse, err := common.ReadOrganizationAncestry(context.Background(), newTestClient(server.URL), sonatypeiq.BasicAuth{}, "missing")
+
+	assert.Error(t, err)
+	assert.Nil(t, organizationIds)
+	assert.Equal(t, http.StatusNotFound, httpResponse.StatusCode)
+}
+
+func TestReadOrganizationDescendants(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/organizations", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"organizations":[
+			{"id":"ROOT_ORGANIZATION_ID","name":"Root Organization"},
+			{"id":"a","name":"A","parentOrganizationId":"ROOT_ORGANIZATION_ID"},
+			{"id":"b","name":"B","parentOrganizationId":"a"},
+			{"id":"c","name":"C","parentOrganizationId":"b"},
+			{"id":"d","name":"D","parentOrganizationId":"ROOT_ORGANIZATION_ID"}
+		]}`))
+	}))
+	defer server.Close()
+
+	organizationIds, _, err := common.ReadOrganizationDescendants(context.Background(), newTestClient(server.URL), sonatypeiq.BasicAuth{}, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, organizationIds)
+
+	organizationIds, _, err = common.ReadOrganizationDescendants(context.Background(), newTestClient(server.URL), sonatypeiq.BasicAuth{}, "missing")
+	assert.NoError(t, err)
+	assert.Empty(t, organizationIds)
+}
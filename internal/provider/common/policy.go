@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+type ownerPoliciesResponse struct {
+	Policies []struct {
+		Id      string            `json:"id"`
+		Actions map[string]string `json:"actions"`
+	} `json:"policies"`
+}
+
+// ReadPolicyActions returns the action each Policy owned by an Organization or Application takes at each stage,
+// keyed by Policy ID then stage ID - for example `build` => `warn`. ownerType is `organization` or `application`.
+func ReadPolicyActions(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth, ownerType, ownerId string) (map[string]map[string]string, *http.Response, error) {
+	apiResponse := ownerPoliciesResponse{}
+	httpResponse, err := ExecuteRestJsonRequest(ctx, client, auth, http.MethodGet, fmt.Sprintf(REST_PATH_POLICIES, strings.ToLower(ownerType), ownerId), nil, &apiResponse)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	actions := make(map[string]map[string]string)
+	for _, policy := range apiResponse.Policies {
+		actions[policy.Id] = policy.Actions
+		if actions[policy.Id] == nil {
+			actions[policy.Id] = make(map[string]string)
+		}
+	}
+	return actions, httpResponse, nil
+}
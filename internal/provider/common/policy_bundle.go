@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Keys in a Policy export holding the IDs Sonatype IQ Server generates for each element on import, and the owner -
+// these differ between Organizations / servers without the Policies themselves differing
+var policyBundleVolatileKeys = map[string]bool{
+	"id":      true,
+	"ownerId": true,
+}
+
+// Keys in a Policy export that reference another element by its ID - e.g. which Application Category a Policy
+// applies to, or which License Threat Group a License belongs to. These are compared by the referenced element's name.
+var policyBundleReferenceKeys = map[string]bool{
+	"licenseThreatGroupId": true,
+	"policyId":             true,
+	"tagId":                true,
+}
+
+// NormalizePolicyBundle returns a canonical form of a Policy export (as produced by the Sonatype IQ UI) so that
+// two exports can be compared regardless of formatting, key order or the order of entries in each list.
+//
+// References between elements are resolved to the referenced element's name, so re-assigning (say) an Application
+// Category to a different Policy is seen as a change, whilst the IDs generated on import are not.
+func NormalizePolicyBundle(policyJson string) (string, error) {
+	var bundle any
+	if err := json.Unmarshal([]byte(policyJson), &bundle); err != nil {
+		return "", err
+	}
+
+	names := make(map[string]string)
+	collectPolicyBundleNames(bundle, names)
+
+	normalized, err := json.Marshal(normalizePolicyBundleValue(bundle, names))
+	if err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}
+
+// collectPolicyBundleNames indexes every element in the export that has both an ID and a name
+func collectPolicyBundleNames(v any, names map[string]string) {
+	switch val := v.(type) {
+	case map[string]any:
+		id, hasId := val["id"].(string)
+		name, hasName := val["name"].(string)
+		if hasId && hasName {
+			names[id] = name
+		}
+		for _, child := range val {
+			collectPolicyBundleNames(child, names)
+		}
+	case []any:
+		for _, child := range val {
+			collectPolicyBundleNames(child, names)
+		}
+	}
+}
+
+func normalizePolicyBundleValue(v any, names map[string]string) any {
+	switch val := v.(type) {
+	case map[string]any:
+		// encoding/json writes map keys in sorted order
+		normalized := make(map[string]any, len(val))
+		for k, child := range val {
+			if policyBundleVolatileKeys[k] {
+				continue
+			}
+			if policyBundleReferenceKeys[k] {
+				// An unresolvable reference is kept as-is
+				if id, ok := child.(string); ok {
+					if name, found := names[id]; found {
+						normalized[k] = name
+						continue
+					}
+				}
+			}
+			normalized[k] = normalizePolicyBundleValue(child, names)
+		}
+		return normalized
+	case []any:
+		encoded := make([]string, 0, len(val))
+		for _, child := range val {
+			childJson, _ := json.Marshal(normalizePolicyBundleValue(child, names))
+			encoded = append(encoded, string(childJson))
+		}
+		sort.Strings(encoded)
+		normalized := make([]any, 0, len(encoded))
+		for _, e := range encoded {
+			normalized = append(normalized, json.RawMessage(e))
+		}
+		return normalized
+	}
+	return v
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = PolicyBundleJsonType{}
+	_ basetypes.StringValuableWithSemanticEquals = PolicyBundleJsonValue{}
+)
+
+// PolicyBundleJsonType is a string attribute type holding a Policy export. Values are equal if their normalized
+// forms are equal, so reformatting or reordering the JSON is not seen as a change.
+type PolicyBundleJsonType struct {
+	basetypes.StringType
+}
+
+// String is shown as the attribute type in generated documentation.
+func (t PolicyBundleJsonType) String() string {
+	return "String"
+}
+
+func (t PolicyBundleJsonType) Equal(o attr.Type) bool {
+	other, ok := o.(PolicyBundleJsonType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t PolicyBundleJsonType) ValueType(ctx context.Context) attr.Value {
+	return PolicyBundleJsonValue{}
+}
+
+func (t PolicyBundleJsonType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return PolicyBundleJsonValue{StringValue: in}, nil
+}
+
+func (t PolicyBundleJsonType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return PolicyBundleJsonValue{StringValue: stringValue}, nil
+}
+
+// PolicyBundleJsonValue is a value of PolicyBundleJsonType.
+type PolicyBundleJsonValue struct {
+	basetypes.StringValue
+}
+
+// NewPolicyBundleJsonValue returns a known PolicyBundleJsonValue.
+func NewPolicyBundleJsonValue(policyJson string) PolicyBundleJsonValue {
+	return PolicyBundleJsonValue{StringValue: basetypes.NewStringValue(policyJson)}
+}
+
+func (v PolicyBundleJsonValue) Type(ctx context.Context) attr.Type {
+	return PolicyBundleJsonType{}
+}
+
+func (v PolicyBundleJsonValue) Equal(o attr.Value) bool {
+	other, ok := o.(PolicyBundleJsonValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether two Policy exports describe the same Policies. Invalid JSON is never
+// semantically equal to anything.
+func (v PolicyBundleJsonValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(PolicyBundleJsonValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected value type %T but got %T", v, newValuable))
+		return false, diags
+	}
+
+	current, err := NormalizePolicyBundle(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	proposed, err := NormalizePolicyBundle(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return current == proposed, diags
+}
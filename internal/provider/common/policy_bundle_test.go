@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	"context"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePolicyBundle(t *testing.T) {
+	a := `{
+  "policies": [
+    {"id": "p2", "name": "Security-High", "threatLevel": 9, "ownerId": "ROOT_ORGANIZATION_ID"},
+    {"id": "p1", "name": "Security-Critical", "threatLevel": 10, "ownerId": "ROOT_ORGANIZATION_ID"}
+  ],
+  "labels": []
+}`
+	b := `{"labels":[],"policies":[{"name":"Security-Critical","threatLevel":10,"id":"p1","ownerId":"abc"},{"threatLevel":9,"id":"p2","name":"Security-High","ownerId":"abc"}]}`
+
+	normalizedA, err := common.NormalizePolicyBundle(a)
+	assert.NoError(t, err)
+	normalizedB, err := common.NormalizePolicyBundle(b)
+	assert.NoError(t, err)
+	assert.Equal(t, normalizedA, normalizedB)
+	assert.NotContains(t, normalizedA, "ownerId")
+
+	changed, err := common.NormalizePolicyBundle(`{"labels":[],"policies":[{"id":"p1","name":"Security-Critical","threatLevel":8},{"id":"p2","name":"Security-High","threatLevel":9}]}`)
+	assert.NoError(t, err)
+	assert.NotEqual(t, normalizedA, changed)
+}
+
+func TestNormalizePolicyBundleInvalid(t *testing.T) {
+	_, err := common.NormalizePolicyBundle("not json")
+	assert.Error(t, err)
+}
+
+func TestNormalizePolicyBundleIgnoresServerIds(t *testing.T) {
+	exported := `{
+  "policies": [{"id": "a1", "name": "Security-High", "ownerId": "org-1", "constraints": [{"id": "c1", "conditions": [{"id": "x1", "conditionTypeId": "SecurityVulnerabilitySeverity", "value": "7"}]}]}],
+  "licenseThreatGroups": [{"id": "l1", "name": "Copyleft"}],
+  "licenseThreatGroupLicenses": [{"id": "g1", "licenseThreatGroupId": "l1", "licenseId": "GPL-3.0-only"}],
+  "tags": [{"id": "tag-1", "name": "Distributed"}],
+  "policyTags": [{"id": "t1", "policyId": "a1", "tagId": "tag-1"}]
+}`
+	reimported := `{
+  "policies": [{"id": "b2", "name": "Security-High", "ownerId": "org-2", "constraints": [{"id": "c2", "conditions": [{"id": "x2", "conditionTypeId": "SecurityVulnerabilitySeverity", "value": "7"}]}]}],
+  "licenseThreatGroups": [{"id": "l2", "name": "Copyleft"}],
+  "licenseThreatGroupLicenses": [{"id": "g2", "licenseThreatGroupId": "l2", "licenseId": "GPL-3.0-only"}],
+  "tags": [{"id": "tag-2", "name": "Distributed"}],
+  "policyTags": [{"id": "t2", "policyId": "b2", "tagId": "tag-2"}]
+}`
+
+	normalizedExported, err := common.NormalizePolicyBundle(exported)
+	assert.NoError(t, err)
+	normalizedReimported, err := common.NormalizePolicyBundle(reimported)
+	assert.NoError(t, err)
+	assert.Equal(t, normalizedExported, normalizedReimported)
+	assert.Contains(t, normalizedExported, "GPL-3.0-only")
+}
+
+func TestNormalizePolicyBundleComparesReferencesByName(t *testing.T) {
+	bundle := func(policyTagId string, licenseGroupId string) string {
+		return `{
+  "policies": [{"id": "p1", "name": "Security-High"}],
+  "tags": [{"id": "tag-1", "name": "Distributed"}, {"id": "tag-2", "name": "Hosted"}],
+  "policyTags": [{"id": "t1", "policyId": "p1", "tagId": "` + policyTagId + `"}],
+  "licenseThreatGroups": [{"id": "l1", "name": "Copyleft"}, {"id": "l2", "name": "Banned"}],
+  "licenseThreatGroupLicenses": [{"id": "g1", "licenseThreatGroupId": "` + licenseGroupId + `", "licenseId": "GPL-3.0-only"}]
+}`
+	}
+
+	original, err := common.NormalizePolicyBundle(bundle("tag-1", "l1"))
+	assert.NoError(t, err)
+	assert.Contains(t, original, `"tagId":"Distributed"`)
+	assert.Contains(t, original, `"licenseThreatGroupId":"Copyleft"`)
+
+	retagged, err := common.NormalizePolicyBundle(bundle("tag-2", "l1"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, original, retagged)
+
+	regrouped, err := common.NormalizePolicyBundle(bundle("tag-1", "l2"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, original, regrouped)
+}
+
+func TestPolicyBundleJsonValueSemanticEquals(t *testing.T) {
+	configured := common.NewPolicyBundleJsonValue(`{
+  "labels": [],
+  "policies": [{"name": "Security-Critical", "threatLevel": 10}]
+}`)
+
+	equal, diags := configured.StringSemanticEquals(context.Background(), common.NewPolicyBundleJsonValue(`{"policies":[{"id":"p1","threatLevel":10,"name":"Security-Critical","ownerId":"org"}],"labels":[]}`))
+	assert.False(t, diags.HasError())
+	assert.True(t, equal)
+
+	equal, _ = configured.StringSemanticEquals(context.Background(), common.NewPolicyBundleJsonValue(`{"policies":[{"threatLevel":9,"name":"Security-Critical"}],"labels":[]}`))
+	assert.False(t, equal)
+
+	equal, _ = configured.StringSemanticEquals(context.Background(), common.NewPolicyBundleJsonValue("not json"))
+	assert.False(t, equal)
+}
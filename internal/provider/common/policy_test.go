@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"testing"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadPolicyActions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf(common.REST_PATH_POLICIES, "organization", "org-1"), r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"policies":[{"id":"p1","name":"Security-Critical","actions":{"build":"warn","release":"fail"}},{"id":"p2","name":"Architecture-Quality"}]}`))
+	}))
+	defer server.Close()
+
+	actions, _, err := common.ReadPolicyActions(context.Background(), newTestClient(server.URL), sonatypeiq.BasicAuth{}, "ORGANIZATION", "org-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		"p1": {"build": "warn", "release": "fail"},
+		"p2": {},
+	}, actions)
+}
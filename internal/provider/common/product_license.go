@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// ProductLicenseDetails is the subset of the installed Product License details reported by Sonatype IQ Server.
+// Values the server does not report are left nil.
+type ProductLicenseDetails struct {
+	ExpiryTimestamp *int64 `json:"expiryTimestamp,omitempty"`
+	LicensedUsers   *int32 `json:"licensedUsers,omitempty"`
+	ActiveUsers     *int32 `json:"activeUsers,omitempty"`
+}
+
+// ReadProductLicense returns the details of the Product License installed in Sonatype IQ Server.
+//
+// REST_PATH_PRODUCT_LICENSE is an internal endpoint used by the IQ Server UI - it is not part of the supported API
+// and its response may change between versions. A response that has none of the expected fields is an error, so
+// that such a change is noticed rather than silently reported as null values.
+func ReadProductLicense(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth) (*ProductLicenseDetails, *http.Response, error) {
+	var fields map[string]json.RawMessage
+	httpResponse, err := ExecuteRestJsonRequest(ctx, client, auth, http.MethodGet, REST_PATH_PRODUCT_LICENSE, nil, &fields)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	details := &ProductLicenseDetails{}
+	for name, target := range map[string]any{
+		"expiryTimestamp": &details.ExpiryTimestamp,
+		"licensedUsers":   &details.LicensedUsers,
+		"activeUsers":     &details.ActiveUsers,
+	} {
+		if value, ok := fields[name]; ok {
+			if err := json.Unmarshal(value, target); err != nil {
+				return nil, httpResponse, fmt.Errorf("unexpected value for %q in Product License details: %w", name, err)
+			}
+		}
+	}
+
+	if details.ExpiryTimestamp == nil && details.LicensedUsers == nil && details.ActiveUsers == nil {
+		return nil, httpResponse, fmt.Errorf("unexpected Product License details response from %s - this version of Sonatype IQ Server is not supported", REST_PATH_PRODUCT_LICENSE)
+	}
+	return details, httpResponse, nil
+}
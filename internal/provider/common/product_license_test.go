@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"testing"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadProductLicense(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, common.REST_PATH_PRODUCT_LICENSE, r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"expiryTimestamp":1767225600000,"licensedUsers":100,"fingerprint":"abc"}`))
+	}))
+	defer server.Close()
+
+	details, httpResponse, err := common.ReadProductLicense(context.Background(), newTestClient(server.URL), sonatypeiq.BasicAuth{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, httpResponse.StatusCode)
+	assert.Equal(t, int64(1767225600000), *details.ExpiryTimestamp)
+	assert.Equal(t, int32(100), *details.LicensedUsers)
+	assert.Nil(t, details.ActiveUsers)
+}
+
+func TestReadProductLicenseResponseShape(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{"all fields", `{"expiryTimestamp":1767225600000,"licensedUsers":100,"activeUsers":42}`, ""},
+		{"expiry only", `{"expiryTimestamp":1767225600000}`, ""},
+		{"renamed fields", `{"expiry":"2026-01-01","seats":100}`, "not supported"},
+		{"empty object", `{}`, "not supported"},
+		{"expiry as a date", `{"expiryTimestamp":"2026-01-01T00:00:00Z"}`, "expiryTimestamp"},
+		{"users as a string", `{"expiryTimestamp":1767225600000,"licensedUsers":"100"}`, "licensedUsers"},
+		{"not an object", `[]`, "cannot unmarshal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			details, _, err := common.ReadProductLicense(context.Background(), newTestClient(server.URL), sonatypeiq.BasicAuth{})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				assert.NotNil(t, details.ExpiryTimestamp)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Nil(t, details)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// Sonatype IQ Server endpoints that are not (yet) available through the generated API client.
+const (
+	// The generated client always requests CycloneDX as JSON - this is only used to download it as XML
+	REST_PATH_CYCLONEDX_REPORT              string = "/api/v2/cycloneDx/%s/%s/reports/%s"
+	REST_PATH_FEATURES                      string = "/api/v2/config/features"
+	REST_PATH_LICENSES                      string = "/rest/license/organization/%s"
+	REST_PATH_LICENSE_THREAT_GROUPS         string = "/rest/licenseThreatGroup/organization/%s"
+	REST_PATH_LICENSE_THREAT_GROUP_LICENSES string = "/rest/licenseThreatGroupLicense/organization/%s/%s"
+	REST_PATH_POLICIES                      string = "/rest/policy/%s/%s"
+	REST_PATH_POLICY_EXPORT                 string = "/rest/policy/organization/%s/export"
+	REST_PATH_POLICY_IMPORT                 string = "/rest/policy/organization/%s/import"
+	REST_PATH_POLICY_TAGS                   string = "/rest/policyTags/organization/%s/%s"
+	REST_PATH_PRODUCT_LICENSE               string = "/rest/product/license"
+	REST_PATH_SBOM_IMPORT                   string = "/api/v2/sbom/import"
+	REST_PATH_SBOM_VERSION                  string = "/api/v2/sbom/applications/%s/versions/%s"
+)
+
+// ExecuteRestRequest calls a Sonatype IQ Server endpoint that is not exposed by the generated API client,
+// re-using the client configuration (server URL, HTTP client, headers) and the supplied credentials.
+//
+// The response body is returned and also left readable on the returned http.Response so that errors can
+// be reported through HandleApiError. A non-2xx response is returned as an error.
+func ExecuteRestRequest(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth, method, path, contentType string, body io.Reader) ([]byte, *http.Response, error) {
+	return executeRestRequest(ctx, client, auth, method, path, contentType, "application/json, */*", body)
+}
+
+// DownloadRestContent is ExecuteRestRequest for a GET of a document in a specific format - for example an XML SBOM.
+func DownloadRestContent(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth, path, accept string) ([]byte, *http.Response, error) {
+	return executeRestRequest(ctx, client, auth, http.MethodGet, path, "", accept, nil)
+}
+
+func executeRestRequest(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth, method, path, contentType, accept string, body io.Reader) ([]byte, *http.Response, error) {
+	cfg := client.GetConfig()
+	baseUrl, err := cfg.ServerURLWithContext(ctx, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(baseUrl, "/")+path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range cfg.DefaultHeader {
+		req.Header.Set(k, v)
+	}
+	if cfg.UserAgent != "" {
+		req.Header.Set("User-Agent", cfg.UserAgent)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", accept)
+	req.SetBasicAuth(auth.UserName, auth.Password)
+
+	httpResponse, err := cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	respBody, err := io.ReadAll(httpResponse.Body)
+	_ = httpResponse.Body.Close()
+	httpResponse.Body = io.NopCloser(bytes.NewBuffer(respBody))
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	if httpResponse.StatusCode >= http.StatusMultipleChoices {
+		return respBody, httpResponse, errors.New(httpResponse.Status)
+	}
+
+	return respBody, httpResponse, nil
+}
+
+// ExecuteRestJsonRequest is ExecuteRestRequest for JSON endpoints - request (if not nil) is sent as the JSON body
+// and a successful response is decoded into result (if not nil).
+func ExecuteRestJsonRequest(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth, method, path string, request any, result any) (*http.Response, error) {
+	var body io.Reader
+	var contentType string
+	if request != nil {
+		reqBody, err := json.Marshal(request)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewBuffer(reqBody)
+		contentType = "application/json"
+	}
+
+	respBody, httpResponse, err := ExecuteRestRequest(ctx, client, auth, method, path, contentType, body)
+	if err != nil {
+		return httpResponse, err
+	}
+
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return httpResponse, err
+		}
+	}
+
+	return httpResponse, nil
+}
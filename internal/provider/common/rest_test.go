@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"testing"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(url string) *sonatypeiq.APIClient {
+	configuration := sonatypeiq.NewConfiguration()
+	configuration.Servers = []sonatypeiq.ServerConfiguration{{URL: url}}
+	return sonatypeiq.NewAPIClient(configuration)
+}
+
+func TestExecuteRestJsonRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", username)
+		assert.Equal(t, "secret", password)
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/rest/thing/abc", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var in map[string]string
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &in))
+		assert.Equal(t, "value", in["key"])
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"result":"ok"}`))
+	}))
+	defer server.Close()
+
+	var out map[string]string
+	httpResponse, err := common.ExecuteRestJsonRequest(
+		context.Background(),
+		newTestClient(server.URL+"/"),
+		sonatypeiq.BasicAuth{UserName: "admin", Password: "secret"},
+		http.MethodPut,
+		"/rest/thing/abc",
+		map[string]string{"key": "value"},
+		&out,
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, httpResponse.StatusCode)
+	assert.Equal(t, "ok", out["result"])
+}
+
+func TestExecuteRestRequestErrorKeepsBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("bad things"))
+	}))
+	defer server.Close()
+
+	respBody, httpResponse, err := common.ExecuteRestRequest(
+		context.Background(),
+		newTestClient(server.URL),
+		sonatypeiq.BasicAuth{},
+		http.MethodGet,
+		"/rest/thing",
+		"",
+		nil,
+	)
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, httpResponse.StatusCode)
+	assert.Equal(t, "bad things", string(respBody))
+	bodyAgain, _ := io.ReadAll(httpResponse.Body)
+	assert.Equal(t, "bad things", string(bodyAgain))
+}
@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+const (
+	SBOM_IMPORT_STATUS_COMPLETED string = "COMPLETED"
+	SBOM_IMPORT_STATUS_FAILED    string = "FAILED"
+)
+
+// SBOM formats that can be exported for an Application evaluation
+const (
+	SBOM_FORMAT_CYCLONEDX_JSON string = "cyclonedx-json"
+	SBOM_FORMAT_CYCLONEDX_XML  string = "cyclonedx-xml"
+	SBOM_FORMAT_SPDX_JSON      string = "spdx-json"
+
+	DEFAULT_CYCLONEDX_VERSION string = "1.6"
+	DEFAULT_SPDX_VERSION      string = "2.3"
+)
+
+// How long to wait for Sonatype IQ Server to process an imported SBOM, unless a timeout is configured
+const SBOM_IMPORT_DEFAULT_TIMEOUT = 5 * time.Minute
+
+// How often the status of an SBOM import is checked
+var SbomImportPollInterval = 2 * time.Second
+
+var errSbomImportTimedOut = errors.New("timed out waiting for SBOM import to complete")
+
+type sbomImportResponse struct {
+	StatusUrl string `json:"statusUrl"`
+}
+
+type sbomImportStatusResponse struct {
+	ProcessingStatus string `json:"processingStatus"`
+	SbomId           string `json:"sbomId"`
+	ErrorMessage     string `json:"errorMessage"`
+}
+
+// ImportSbom uploads a CycloneDX or SPDX document to SBOM Manager for the given Application version and waits
+// for Sonatype IQ Server to finish processing it - or for ctx to be done. The server-side SBOM identifier is returned.
+func ImportSbom(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth, applicationId, applicationVersion, fileName string, content []byte) (string, *http.Response, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	_ = writer.WriteField("applicationId", applicationId)
+	_ = writer.WriteField("applicationVersion", applicationVersion)
+	part, err := writer.CreateFormFile("file", fileName)
+	if err == nil {
+		_, err = part.Write(content)
+	}
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	var importResponse sbomImportResponse
+	respBody, httpResponse, err := ExecuteRestRequest(ctx, client, auth, http.MethodPost, REST_PATH_SBOM_IMPORT, writer.FormDataContentType(), body)
+	if err != nil {
+		return "", httpResponse, err
+	}
+	if err := json.Unmarshal(respBody, &importResponse); err != nil || importResponse.StatusUrl == "" {
+		return "", httpResponse, fmt.Errorf("unexpected response to SBOM import: %s", string(respBody))
+	}
+
+	statusPath := "/" + strings.TrimLeft(importResponse.StatusUrl, "/")
+	if u, err := url.Parse(importResponse.StatusUrl); err == nil && u.IsAbs() {
+		statusPath = u.Path
+	}
+
+	for {
+		var status sbomImportStatusResponse
+		httpResponse, err = ExecuteRestJsonRequest(ctx, client, auth, http.MethodGet, statusPath, nil, &status)
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return "", httpResponse, errSbomImportTimedOut
+		}
+		if err != nil && (httpResponse == nil || httpResponse.StatusCode != http.StatusNotFound) {
+			return "", httpResponse, err
+		}
+
+		switch status.ProcessingStatus {
+		case SBOM_IMPORT_STATUS_COMPLETED:
+			return status.SbomId, httpResponse, nil
+		case SBOM_IMPORT_STATUS_FAILED:
+			return "", httpResponse, fmt.Errorf("SBOM import failed: %s", status.ErrorMessage)
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return "", httpResponse, errSbomImportTimedOut
+			}
+			return "", httpResponse, ctx.Err()
+		case <-time.After(SbomImportPollInterval):
+		}
+	}
+}
+
+// ReadSbom returns the SBOM for the given Application version from SBOM Manager. A 404 response means that no
+// SBOM exists for the version.
+func ReadSbom(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth, applicationId, applicationVersion string) ([]byte, *http.Response, error) {
+	return ExecuteRestRequest(
+		ctx,
+		client,
+		auth,
+		http.MethodGet,
+		fmt.Sprintf(REST_PATH_SBOM_VERSION, url.PathEscape(applicationId), url.PathEscape(applicationVersion)),
+		"",
+		nil,
+	)
+}
+
+// DeleteSbom removes the SBOM for the given Application version from SBOM Manager.
+func DeleteSbom(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth, applicationId, applicationVersion string) (*http.Response, error) {
+	_, httpResponse, err := ExecuteRestRequest(
+		ctx,
+		client,
+		auth,
+		http.MethodDelete,
+		fmt.Sprintf(REST_PATH_SBOM_VERSION, url.PathEscape(applicationId), url.PathEscape(applicationVersion)),
+		"",
+		nil,
+	)
+	return httpResponse, err
+}
+
+// ExportSbom generates an SBOM in the given format (one of the SBOM_FORMAT_ values) from an evaluation report
+// for an Application. An empty specVersion uses the default version of the format.
+func ExportSbom(ctx context.Context, client *sonatypeiq.APIClient, auth sonatypeiq.BasicAuth, applicationId, reportId, format, specVersion string) ([]byte, *http.Response, error) {
+	switch format {
+	case SBOM_FORMAT_SPDX_JSON:
+		if specVersion == "" {
+			specVersion = DEFAULT_SPDX_VERSION
+		}
+		content, httpResponse, err := client.SPDXAPI.GetByScanId(WithAuth(ctx, auth), applicationId, reportId).
+			Format("json").
+			SpdxVersion(specVersion).
+			Execute()
+		return []byte(content), httpResponse, err
+
+	case SBOM_FORMAT_CYCLONEDX_JSON:
+		if specVersion == "" {
+			specVersion = DEFAULT_CYCLONEDX_VERSION
+		}
+		// The generated client does not decode the SBOM, but leaves it in the response body
+		httpResponse, err := client.CycloneDXAPI.GetByReportId(WithAuth(ctx, auth), applicationId, reportId, specVersion).Execute()
+		if err != nil {
+			return nil, httpResponse, err
+		}
+		content, err := io.ReadAll(httpResponse.Body)
+		return content, httpResponse, err
+
+	case SBOM_FORMAT_CYCLONEDX_XML:
+		if specVersion == "" {
+			specVersion = DEFAULT_CYCLONEDX_VERSION
+		}
+		return DownloadRestContent(
+			ctx,
+			client,
+			auth,
+			fmt.Sprintf(REST_PATH_CYCLONEDX_REPORT, url.PathEscape(specVersion), url.PathEscape(applicationId), url.PathEscape(reportId)),
+			"application/xml",
+		)
+	}
+
+	return nil, nil, fmt.Errorf("unsupported SBOM format %q", format)
+}
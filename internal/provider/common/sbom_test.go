@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"testing"
+	"time"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSbom = `{"bomFormat":"CycloneDX","specVersion":"1.5","components":[]}`
+
+const sbomImportStatusProcessing = "PROCESSING"
+
+func newSbomManagerStandIn(t *testing.T, finalStatus string) (*httptest.Server, *int) {
+	t.Helper()
+	statusChecks := 0
+	sbomExists := true
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/sbom/import", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "app-internal-id", r.FormValue("applicationId"))
+		assert.Equal(t, "1.2.3", r.FormValue("applicationVersion"))
+		file, header, err := r.FormFile("file")
+		assert.NoError(t, err)
+		assert.Equal(t, "bom.json", header.Filename)
+		content, _ := io.ReadAll(file)
+		assert.Equal(t, testSbom, string(content))
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"statusUrl":"api/v2/sbom/import/status/ticket-1"}`))
+	})
+	mux.HandleFunc("/api/v2/sbom/import/status/ticket-1", func(w http.ResponseWriter, r *http.Request) {
+		statusChecks++
+		switch {
+		case statusChecks == 1:
+			w.WriteHeader(http.StatusNotFound)
+		case statusChecks == 2, finalStatus == sbomImportStatusProcessing:
+			_, _ = w.Write([]byte(`{"processingStatus":"PROCESSING"}`))
+		case finalStatus == common.SBOM_IMPORT_STATUS_FAILED:
+			_, _ = w.Write([]byte(`{"processingStatus":"FAILED","errorMessage":"unsupported format"}`))
+		default:
+			_, _ = w.Write([]byte(`{"processingStatus":"COMPLETED","sbomId":"sbom-123"}`))
+		}
+	})
+	mux.HandleFunc("/api/v2/sbom/applications/app-internal-id/versions/1.2.3", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case !sbomExists:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodDelete:
+			sbomExists = false
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			assert.Equal(t, http.MethodGet, r.Method)
+			_, _ = w.Write([]byte(testSbom))
+		}
+	})
+
+	pollInterval := common.SbomImportPollInterval
+	common.SbomImportPollInterval = time.Millisecond
+	t.Cleanup(func() { common.SbomImportPollInterval = pollInterval })
+	return httptest.NewServer(mux), &statusChecks
+}
+
+func TestImportSbom(t *testing.T) {
+	server, statusChecks := newSbomManagerStandIn(t, common.SBOM_IMPORT_STATUS_COMPLETED)
+	defer server.Close()
+
+	sbomId, _, err := common.ImportSbom(
+		context.Background(),
+		newTestClient(server.URL),
+		sonatypeiq.BasicAuth{UserName: "admin", Password: "secret"},
+		"app-internal-id",
+		"1.2.3",
+		"bom.json",
+		[]byte(testSbom),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "sbom-123", sbomId)
+	assert.Equal(t, 3, *statusChecks)
+}
+
+func TestImportSbomFailed(t *testing.T) {
+	server, _ := newSbomManagerStandIn(t, common.SBOM_IMPORT_STATUS_FAILED)
+	defer server.Close()
+
+	_, _, err := common.ImportSbom(
+		context.Background(),
+		newTestClient(server.URL),
+		sonatypeiq.BasicAuth{},
+		"app-internal-id",
+		"1.2.3",
+		"bom.json",
+		[]byte(testSbom),
+	)
+
+	assert.ErrorContains(t, err, "unsupported format")
+}
+
+func TestImportSbomTimeout(t *testing.T) {
+	server, _ := newSbomManagerStandIn(t, sbomImportStatusProcessing)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, _, err := common.ImportSbom(
+		ctx,
+		newTestClient(server.URL),
+		sonatypeiq.BasicAuth{},
+		"app-internal-id",
+		"1.2.3",
+		"bom.json",
+		[]byte(testSbom),
+	)
+
+	assert.ErrorContains(t, err, "timed out waiting for SBOM import")
+}
+
+func TestReadSbom(t *testing.T) {
+	server, _ := newSbomManagerStandIn(t, common.SBOM_IMPORT_STATUS_COMPLETED)
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	content, _, err := common.ReadSbom(context.Background(), client, sonatypeiq.BasicAuth{}, "app-internal-id", "1.2.3")
+	assert.NoError(t, err)
+	assert.Equal(t, testSbom, string(content))
+
+	_, err = common.DeleteSbom(context.Background(), client, sonatypeiq.BasicAuth{}, "app-internal-id", "1.2.3")
+	assert.NoError(t, err)
+
+	_, httpResponse, err := common.ReadSbom(context.Background(), client, sonatypeiq.BasicAuth{}, "app-internal-id", "1.2.3")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, httpResponse.StatusCode)
+}
+
+func TestDeleteSbom(t *testing.T) {
+	server, _ := newSbomManagerStandIn(t, common.SBOM_IMPORT_STATUS_COMPLETED)
+	defer server.Close()
+
+	httpResponse, err := common.DeleteSbom(
+		context.Background(),
+		newTestClient(server.URL),
+		sonatypeiq.BasicAuth{},
+		"app-internal-id",
+		"1.2.3",
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, httpResponse.StatusCode)
+}
+
+func TestExportSbom(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/cycloneDx/1.6/app-internal-id/reports/report-1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") == "application/xml" {
+			_, _ = w.Write([]byte(`<bom/>`))
+			return
+		}
+		_, _ = w.Write([]byte(testSbom))
+	})
+	mux.HandleFunc("/api/v2/spdx/app-internal-id/reports/report-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "json", r.URL.Query().Get("format"))
+		assert.Equal(t, "2.2", r.URL.Query().Get("spdxVersion"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"spdxVersion":"SPDX-2.2"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	auth := sonatypeiq.BasicAuth{UserName: "admin", Password: "secret"}
+
+	content, _, err := common.ExportSbom(context.Background(), client, auth, "app-internal-id", "report-1", common.SBOM_FORMAT_CYCLONEDX_JSON, "")
+	assert.NoError(t, err)
+	assert.Equal(t, testSbom, string(content))
+
+	content, _, err = common.ExportSbom(context.Background(), client, auth, "app-internal-id", "report-1", common.SBOM_FORMAT_CYCLONEDX_XML, "")
+	assert.NoError(t, err)
+	assert.Equal(t, `<bom/>`, string(content))
+
+	content, _, err = common.ExportSbom(context.Background(), client, auth, "app-internal-id", "report-1", common.SBOM_FORMAT_SPDX_JSON, "2.2")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"spdxVersion":"SPDX-2.2"}`, string(content))
+
+	_, _, err = common.ExportSbom(context.Background(), client, auth, "app-internal-id", "report-1", "unknown", "")
+	assert.Error(t, err)
+}
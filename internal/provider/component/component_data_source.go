@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &componentDataSource{}
+	_ datasource.DataSourceWithConfigure = &componentDataSource{}
+)
+
+// ComponentDataSource is a helper function to simplify the provider implementation.
+func ComponentDataSource() datasource.DataSource {
+	return &componentDataSource{}
+}
+
+// componentDataSource is the data source implementation.
+type componentDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *componentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_component"
+}
+
+// Schema defines the schema for the data source.
+func (d *componentDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	licenseAttributes := tfschema.NestedAttributeObject{
+		Attributes: map[string]tfschema.Attribute{
+			"license_id":   schema.DataSourceComputedString("ID of the License"),
+			"license_name": schema.DataSourceComputedString("Name of the License"),
+		},
+	}
+
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get Sonatype intelligence for a Component",
+		Attributes: map[string]tfschema.Attribute{
+			"id":                 schema.DataSourceComputedString("Package URL of the Component"),
+			"package_url":        schema.DataSourceRequiredString("Package URL (purl) of the Component - for example `pkg:maven/commons-collections/commons-collections@3.2.1?type=jar`"),
+			"display_name":       schema.DataSourceComputedString("Display Name of the Component"),
+			"hash":               schema.DataSourceComputedString("Hash of the Component"),
+			"match_state":        schema.DataSourceComputedString("How the Component was matched - `exact`, `similar` or `unknown`"),
+			"catalog_date":       schema.DataSourceComputedString("Date the Component was first catalogued by Sonatype"),
+			"license_status":     schema.DataSourceComputedString("Status of the License data for the Component"),
+			"declared_licenses":  schema.DataSourceComputedListNestedAttribute("Licenses declared by the Component", licenseAttributes),
+			"effective_licenses": schema.DataSourceComputedListNestedAttribute("Effective Licenses of the Component", licenseAttributes),
+			"security_issues": schema.DataSourceComputedListNestedAttribute(
+				"Security Issues known for the Component",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"reference":       schema.DataSourceComputedString("Reference of the Security Issue - for example a CVE ID"),
+						"source":          schema.DataSourceComputedString("Source of the Security Issue"),
+						"severity":        schema.DataSourceComputedFloat64("Severity (CVSS score) of the Security Issue"),
+						"threat_category": schema.DataSourceComputedString("Threat Category of the Security Issue"),
+						"status":          schema.DataSourceComputedString("Status of the Security Issue"),
+						"cvss_vector":     schema.DataSourceComputedString("CVSS Vector of the Security Issue"),
+						"cwe":             schema.DataSourceComputedString("CWE of the Security Issue"),
+						"url":             schema.DataSourceComputedString("URL with details of the Security Issue"),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *componentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.ComponentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	apiRequest := sonatypeiq.ApiComponentDetailsRequestDTOV2{
+		Components: []sonatypeiq.ApiComponentDTOV2{
+			{PackageUrl: data.PackageUrl.ValueStringPointer()},
+		},
+	}
+	apiResponse, httpResponse, err := d.Client.ComponentsAPI.GetComponentDetails(d.AuthContext(ctx)).ApiComponentDetailsRequestDTOV2(apiRequest).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(common.ERR_FAILED_READING_COMPONENT_DETAILS, &err, httpResponse, &resp.Diagnostics)
+		return
+	} else if len(apiResponse.ComponentDetails) != 1 {
+		errors.HandleAPIWarning("No Component details found for supplied Package URL", nil, httpResponse, &resp.Diagnostics)
+		return
+	}
+
+	// Map api response to State
+	data.MapFromApi(&apiResponse.ComponentDetails[0])
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
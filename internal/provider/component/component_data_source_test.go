@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component_test
+
+import (
+	"testing"
+
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccComponentDataSource(t *testing.T) {
+	resourceName := "data.sonatypeiq_component.test"
+	purl := "pkg:maven/commons-collections/commons-collections@3.2.1?type=jar"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read by Package URL
+			{
+				Config: utils_test.ProviderConfig + `data "sonatypeiq_component" "test" {
+					package_url = "` + purl + `"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", purl),
+					resource.TestCheckResourceAttr(resourceName, "package_url", purl),
+					resource.TestCheckResourceAttrSet(resourceName, "display_name"),
+					resource.TestCheckResourceAttr(resourceName, "match_state", "exact"),
+					resource.TestCheckResourceAttr(resourceName, "declared_licenses.0.license_id", "Apache-2.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "security_issues.0.reference"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &vulnerabilityDataSource{}
+	_ datasource.DataSourceWithConfigure = &vulnerabilityDataSource{}
+)
+
+// VulnerabilityDataSource is a helper function to simplify the provider implementation.
+func VulnerabilityDataSource() datasource.DataSource {
+	return &vulnerabilityDataSource{}
+}
+
+// vulnerabilityDataSource is the data source implementation.
+type vulnerabilityDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *vulnerabilityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vulnerability"
+}
+
+// Schema defines the schema for the data source.
+func (d *vulnerabilityDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	severityAttributes := map[string]tfschema.Attribute{
+		"source":       schema.DataSourceComputedString("Source of the score"),
+		"source_label": schema.DataSourceComputedString("Display label for the source of the score"),
+		"score":        schema.DataSourceComputedFloat64("CVSS score"),
+		"vector":       schema.DataSourceComputedString("CVSS vector"),
+	}
+
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get details of a Security Vulnerability",
+		Attributes: map[string]tfschema.Attribute{
+			"id":                 schema.DataSourceComputedString("Identifier of the Vulnerability"),
+			"identifier":         schema.DataSourceRequiredString("CVE ID or Sonatype Vulnerability Identifier - for example `CVE-2021-44228` or `sonatype-2015-0002`"),
+			"description":        schema.DataSourceComputedString("Description of the Vulnerability"),
+			"source":             schema.DataSourceComputedString("Source of the Vulnerability data"),
+			"vulnerability_link": schema.DataSourceComputedString("Link to the Vulnerability details"),
+			"vulnerability_ids":  schema.DataSourceComputedStringList("Other identifiers for the Vulnerability"),
+			"main_severity":      schema.DataSourceComputedSingleNestedAttribute("Primary CVSS severity of the Vulnerability", severityAttributes),
+			"severity_scores": schema.DataSourceComputedListNestedAttribute(
+				"All CVSS severities known for the Vulnerability",
+				tfschema.NestedAttributeObject{Attributes: severityAttributes},
+			),
+			"cwe_ids": schema.DataSourceComputedStringList("CWE IDs of the weakness behind the Vulnerability"),
+			"advisories": schema.DataSourceComputedListNestedAttribute(
+				"Advisory links for the Vulnerability",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"reference_type": schema.DataSourceComputedString("Type of the advisory"),
+						"url":            schema.DataSourceComputedString("URL of the advisory"),
+					},
+				},
+			),
+			"vulnerable_version_ranges": schema.DataSourceComputedStringList("Version ranges affected by the Vulnerability"),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *vulnerabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.VulnerabilityModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	apiResponse, httpResponse, err := d.Client.VulnerabilityDetailsAPI.GetSecurityVulnerabilityDetails1(d.AuthContext(ctx), data.Identifier.ValueString()).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(common.ERR_FAILED_READING_VULNERABILITY, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+
+	// Map api response to State
+	data.MapFromApi(ctx, apiResponse)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component_test
+
+import (
+	"testing"
+
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVulnerabilityDataSource(t *testing.T) {
+	resourceName := "data.sonatypeiq_vulnerability.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read by CVE ID
+			{
+				Config: utils_test.ProviderConfig + `data "sonatypeiq_vulnerability" "test" {
+					identifier = "CVE-2021-44228"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "CVE-2021-44228"),
+					resource.TestCheckResourceAttrSet(resourceName, "description"),
+					resource.TestCheckResourceAttrSet(resourceName, "main_severity.score"),
+					resource.TestCheckResourceAttrSet(resourceName, "main_severity.vector"),
+					resource.TestCheckResourceAttr(resourceName, "cwe_ids.0", "CWE-502"),
+				),
+			},
+		},
+	})
+}
@@ -49,13 +49,20 @@ func (m *ApplicationModel) MapFromApi(api *sonatypeiq.ApiApplicationDTO) {
 // ApplicationsModel
 // ------------------------------------------------------------
 type ApplicationsModel struct {
-	ID           types.String       `tfsdk:"id"`
-	Applications []ApplicationModel `tfsdk:"applications"`
+	ID                      types.String       `tfsdk:"id"`
+	OrganizationId          types.String       `tfsdk:"organization_id"`
+	IncludeSubOrganizations types.Bool         `tfsdk:"include_sub_organizations"`
+	PublicIdPrefix          types.String       `tfsdk:"public_id_prefix"`
+	PublicIdRegex           types.String       `tfsdk:"public_id_regex"`
+	CategoryId              types.String       `tfsdk:"category_id"`
+	ContactUserName         types.String       `tfsdk:"contact_user_name"`
+	MaxResults              types.Int32        `tfsdk:"max_results"`
+	Applications            []ApplicationModel `tfsdk:"applications"`
 }
 
-func (m *ApplicationsModel) MapFromApi(api *sonatypeiq.ApiApplicationListDTO) {
+func (m *ApplicationsModel) MapFromApi(api []sonatypeiq.ApiApplicationDTO) {
 	m.Applications = make([]ApplicationModel, 0)
-	for _, apiApp := range api.Applications {
+	for _, apiApp := range api {
 		app := ApplicationModel{}
 		app.MapFromApi(&apiApp)
 		m.Applications = append(m.Applications, app)
@@ -69,9 +69,10 @@ type ApplicationCategory struct {
 // ApplicationCategories
 // ------------------------------------------------------------
 type ApplicationCategories struct {
-	ID              types.String          `tfsdk:"id"`
-	OrganiziationId types.String          `tfsdk:"organization_id"`
-	Categories      []ApplicationCategory `tfsdk:"categories"`
+	ID               types.String          `tfsdk:"id"`
+	OrganiziationId  types.String          `tfsdk:"organization_id"`
+	IncludeInherited types.Bool            `tfsdk:"include_inherited"`
+	Categories       []ApplicationCategory `tfsdk:"categories"`
 }
 
 func (m *ApplicationCategories) MapFromApi(api *[]sonatypeiq.ApiApplicationCategoryDTO) {
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// ApplicationPolicyViolationsModel
+// ------------------------------------------------------------
+type ApplicationPolicyViolationsModel struct {
+	ID             types.String                      `tfsdk:"id"`
+	ApplicationId  types.String                      `tfsdk:"application_id"`
+	PublicId       types.String                      `tfsdk:"public_id"`
+	Stage          types.String                      `tfsdk:"stage"`
+	ScanId         types.String                      `tfsdk:"scan_id"`
+	EvaluationDate types.String                      `tfsdk:"evaluation_date"`
+	Violations     []ApplicationPolicyViolationModel `tfsdk:"violations"`
+}
+
+func (m *ApplicationPolicyViolationsModel) MapFromApi(app *sonatypeiq.ApiApplicationDTO, report *sonatypeiq.ApiReportResultsDTO, api *sonatypeiq.ApiReportPolicyDataDTOV2) {
+	m.ID = types.StringPointerValue(report.ScanId)
+	m.ApplicationId = types.StringPointerValue(app.Id)
+	m.PublicId = types.StringPointerValue(app.PublicId)
+	m.Stage = types.StringPointerValue(report.Stage)
+	m.ScanId = types.StringPointerValue(report.ScanId)
+	m.EvaluationDate = types.StringNull()
+	if report.EvaluationDate != nil {
+		m.EvaluationDate = types.StringValue(report.EvaluationDate.Format(time.RFC3339))
+	}
+	m.Violations = make([]ApplicationPolicyViolationModel, 0)
+	for _, component := range api.Components {
+		for _, violation := range component.Violations {
+			v := ApplicationPolicyViolationModel{}
+			v.MapFromApi(&component, &violation)
+			m.Violations = append(m.Violations, v)
+		}
+	}
+}
+
+// ApplicationPolicyViolationModel
+// ------------------------------------------------------------
+type ApplicationPolicyViolationModel struct {
+	PolicyViolationId    types.String `tfsdk:"policy_violation_id"`
+	PolicyId             types.String `tfsdk:"policy_id"`
+	PolicyName           types.String `tfsdk:"policy_name"`
+	ThreatLevel          types.Int32  `tfsdk:"threat_level"`
+	ThreatCategory       types.String `tfsdk:"threat_category"`
+	ComponentDisplayName types.String `tfsdk:"component_display_name"`
+	ComponentPurl        types.String `tfsdk:"component_purl"`
+	Waived               types.Bool   `tfsdk:"waived"`
+	LegacyViolation      types.Bool   `tfsdk:"legacy_violation"`
+	Grandfathered        types.Bool   `tfsdk:"grandfathered"`
+}
+
+func (m *ApplicationPolicyViolationModel) MapFromApi(component *sonatypeiq.ApiReportComponentPolicyViolationsDTOV2, api *sonatypeiq.ApiReportPolicyViolationDTOV2) {
+	m.PolicyViolationId = types.StringPointerValue(api.PolicyViolationId)
+	m.PolicyId = types.StringPointerValue(api.PolicyId)
+	m.PolicyName = types.StringPointerValue(api.PolicyName)
+	m.ThreatLevel = types.Int32PointerValue(api.PolicyThreatLevel)
+	m.ThreatCategory = types.StringPointerValue(api.PolicyThreatCategory)
+	m.ComponentDisplayName = types.StringPointerValue(component.DisplayName)
+	m.ComponentPurl = types.StringPointerValue(component.PackageUrl)
+	if component.PackageUrl == nil {
+		m.ComponentPurl = types.StringPointerValue(component.OriginalPurl)
+	}
+	m.Waived = types.BoolValue(api.GetWaived())
+	m.LegacyViolation = types.BoolValue(api.GetLegacyViolation())
+	m.Grandfathered = types.BoolValue(api.GetGrandfathered())
+}
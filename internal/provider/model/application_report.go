@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// ApplicationReportsModel
+// ------------------------------------------------------------
+type ApplicationReportsModel struct {
+	ID            types.String             `tfsdk:"id"`
+	ApplicationId types.String             `tfsdk:"application_id"`
+	PublicId      types.String             `tfsdk:"public_id"`
+	Stage         types.String             `tfsdk:"stage"`
+	MaxResults    types.Int32              `tfsdk:"max_results"`
+	Reports       []ApplicationReportModel `tfsdk:"reports"`
+}
+
+func (m *ApplicationReportsModel) MapFromApi(app *sonatypeiq.ApiApplicationDTO, api *sonatypeiq.ApiReportHistoryDTO, baseUrl string) {
+	m.ID = types.StringPointerValue(app.Id)
+	m.ApplicationId = types.StringPointerValue(app.Id)
+	m.PublicId = types.StringPointerValue(app.PublicId)
+	m.Reports = make([]ApplicationReportModel, 0)
+	for _, apiReport := range api.Reports {
+		report := ApplicationReportModel{}
+		report.MapFromApi(&apiReport, baseUrl)
+		m.Reports = append(m.Reports, report)
+	}
+}
+
+// ApplicationReportModel
+// ------------------------------------------------------------
+type ApplicationReportModel struct {
+	ScanId                            types.String `tfsdk:"scan_id"`
+	Stage                             types.String `tfsdk:"stage"`
+	EvaluationDate                    types.String `tfsdk:"evaluation_date"`
+	ReportHtmlUrl                     types.String `tfsdk:"report_html_url"`
+	ReportPdfUrl                      types.String `tfsdk:"report_pdf_url"`
+	ReportDataUrl                     types.String `tfsdk:"report_data_url"`
+	AffectedComponentCount            types.Int32  `tfsdk:"affected_component_count"`
+	TotalComponentCount               types.Int32  `tfsdk:"total_component_count"`
+	CriticalComponentCount            types.Int32  `tfsdk:"critical_component_count"`
+	SevereComponentCount              types.Int32  `tfsdk:"severe_component_count"`
+	ModerateComponentCount            types.Int32  `tfsdk:"moderate_component_count"`
+	CriticalPolicyViolationCount      types.Int32  `tfsdk:"critical_policy_violation_count"`
+	SeverePolicyViolationCount        types.Int32  `tfsdk:"severe_policy_violation_count"`
+	ModeratePolicyViolationCount      types.Int32  `tfsdk:"moderate_policy_violation_count"`
+	GrandfatheredPolicyViolationCount types.Int32  `tfsdk:"grandfathered_policy_violation_count"`
+	LegacyViolationCount              types.Int32  `tfsdk:"legacy_violation_count"`
+}
+
+func (m *ApplicationReportModel) MapFromApi(api *sonatypeiq.ApiReportResultsDTO, baseUrl string) {
+	m.ScanId = types.StringPointerValue(api.ScanId)
+	m.Stage = types.StringPointerValue(api.Stage)
+	m.EvaluationDate = types.StringNull()
+	if api.EvaluationDate != nil {
+		m.EvaluationDate = types.StringValue(api.EvaluationDate.Format(time.RFC3339))
+	}
+	m.ReportHtmlUrl = reportUrl(baseUrl, api.ReportHtmlUrl)
+	m.ReportPdfUrl = reportUrl(baseUrl, api.ReportPdfUrl)
+	m.ReportDataUrl = reportUrl(baseUrl, api.ReportDataUrl)
+
+	result := api.GetPolicyEvaluationResult()
+	m.AffectedComponentCount = types.Int32Value(result.GetAffectedComponentCount())
+	m.TotalComponentCount = types.Int32Value(result.GetTotalComponentCount())
+	m.CriticalComponentCount = types.Int32Value(result.GetCriticalComponentCount())
+	m.SevereComponentCount = types.Int32Value(result.GetSevereComponentCount())
+	m.ModerateComponentCount = types.Int32Value(result.GetModerateComponentCount())
+	m.CriticalPolicyViolationCount = types.Int32Value(result.GetCriticalPolicyViolationCount())
+	m.SeverePolicyViolationCount = types.Int32Value(result.GetSeverePolicyViolationCount())
+	m.ModeratePolicyViolationCount = types.Int32Value(result.GetModeratePolicyViolationCount())
+	m.GrandfatheredPolicyViolationCount = types.Int32Value(result.GetGrandfatheredPolicyViolationCount())
+	m.LegacyViolationCount = types.Int32Value(result.GetLegacyViolationCount())
+}
+
+// reportUrl makes a report URL returned relative to the Sonatype IQ Server absolute
+func reportUrl(baseUrl string, url *string) types.String {
+	if url == nil {
+		return types.StringNull()
+	}
+	if strings.HasPrefix(*url, "http://") || strings.HasPrefix(*url, "https://") {
+		return types.StringValue(*url)
+	}
+	return types.StringValue(baseUrl + "/" + strings.TrimLeft(*url, "/"))
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// ApplicationSbomModelResource
+// -----------------------------------
+type ApplicationSbomModelResource struct {
+	ID                 types.String   `tfsdk:"id"`
+	ApplicationId      types.String   `tfsdk:"application_id"`
+	ApplicationVersion types.String   `tfsdk:"application_version"`
+	Content            types.String   `tfsdk:"content"`
+	FilePath           types.String   `tfsdk:"file_path"`
+	ContentHash        types.String   `tfsdk:"content_hash"`
+	SbomId             types.String   `tfsdk:"sbom_id"`
+	LastUpdated        types.String   `tfsdk:"last_updated"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ApplicationSbomExportModel
+// -----------------------------------
+type ApplicationSbomExportModel struct {
+	ID             types.String `tfsdk:"id"`
+	ApplicationId  types.String `tfsdk:"application_id"`
+	PublicId       types.String `tfsdk:"public_id"`
+	Stage          types.String `tfsdk:"stage"`
+	Format         types.String `tfsdk:"format"`
+	SpecVersion    types.String `tfsdk:"spec_version"`
+	ScanId         types.String `tfsdk:"scan_id"`
+	EvaluationDate types.String `tfsdk:"evaluation_date"`
+	Content        types.String `tfsdk:"content"`
+	ContentHash    types.String `tfsdk:"content_hash"`
+}
+
+func (m *ApplicationSbomExportModel) MapFromApi(app *sonatypeiq.ApiApplicationDTO, report *sonatypeiq.ApiReportResultsDTO, content []byte, contentHash string) {
+	m.ID = types.StringPointerValue(report.ScanId)
+	m.ApplicationId = types.StringPointerValue(app.Id)
+	m.PublicId = types.StringPointerValue(app.PublicId)
+	m.ScanId = types.StringPointerValue(report.ScanId)
+	m.EvaluationDate = types.StringNull()
+	if report.EvaluationDate != nil {
+		m.EvaluationDate = types.StringValue(report.EvaluationDate.Format(time.RFC3339))
+	}
+	m.Content = types.StringValue(string(content))
+	m.ContentHash = types.StringValue(contentHash)
+}
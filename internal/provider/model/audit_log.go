@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"terraform-provider-sonatypeiq/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AuditLogModel
+// ------------------------------------------------------------
+type AuditLogModel struct {
+	ID        types.String         `tfsdk:"id"`
+	StartDate types.String         `tfsdk:"start_date"`
+	EndDate   types.String         `tfsdk:"end_date"`
+	Entries   []AuditLogEntryModel `tfsdk:"entries"`
+}
+
+func (m *AuditLogModel) MapFromApi(entries []common.AuditLogEntry) {
+	m.ID = types.StringValue(m.StartDate.ValueString() + "," + m.EndDate.ValueString())
+	m.Entries = make([]AuditLogEntryModel, 0, len(entries))
+	for _, entry := range entries {
+		e := AuditLogEntryModel{}
+		e.MapFromApi(entry)
+		m.Entries = append(m.Entries, e)
+	}
+}
+
+// AuditLogEntryModel
+// ------------------------------------------------------------
+type AuditLogEntryModel struct {
+	Timestamp types.String `tfsdk:"timestamp"`
+	Actor     types.String `tfsdk:"actor"`
+	Action    types.String `tfsdk:"action"`
+	Domain    types.String `tfsdk:"domain"`
+	Object    types.String `tfsdk:"object"`
+	Raw       types.String `tfsdk:"raw"`
+}
+
+func (m *AuditLogEntryModel) MapFromApi(entry common.AuditLogEntry) {
+	m.Timestamp = stringValueOrNull(entry.Timestamp)
+	m.Actor = stringValueOrNull(entry.Actor)
+	m.Action = stringValueOrNull(entry.Action)
+	m.Domain = stringValueOrNull(entry.Domain)
+	m.Object = stringValueOrNull(entry.Object)
+	m.Raw = types.StringValue(entry.Raw)
+}
+
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// ComponentModel
+// ------------------------------------------------------------
+type ComponentModel struct {
+	ID                types.String                  `tfsdk:"id"`
+	PackageUrl        types.String                  `tfsdk:"package_url"`
+	DisplayName       types.String                  `tfsdk:"display_name"`
+	Hash              types.String                  `tfsdk:"hash"`
+	MatchState        types.String                  `tfsdk:"match_state"`
+	CatalogDate       types.String                  `tfsdk:"catalog_date"`
+	LicenseStatus     types.String                  `tfsdk:"license_status"`
+	DeclaredLicenses  []ComponentLicenseModel       `tfsdk:"declared_licenses"`
+	EffectiveLicenses []ComponentLicenseModel       `tfsdk:"effective_licenses"`
+	SecurityIssues    []ComponentSecurityIssueModel `tfsdk:"security_issues"`
+}
+
+func (m *ComponentModel) MapFromApi(api *sonatypeiq.ApiComponentDetailsDTOV2) {
+	component := api.GetComponent()
+	m.ID = m.PackageUrl
+	m.DisplayName = types.StringPointerValue(component.DisplayName)
+	m.Hash = types.StringPointerValue(component.Hash.Get())
+	m.MatchState = types.StringPointerValue(api.MatchState)
+	m.CatalogDate = types.StringPointerValue(api.CatalogDate)
+
+	licenseData := api.GetLicenseData()
+	m.LicenseStatus = types.StringPointerValue(licenseData.Status)
+	m.DeclaredLicenses = make([]ComponentLicenseModel, 0)
+	for _, license := range licenseData.DeclaredLicenses {
+		m.DeclaredLicenses = append(m.DeclaredLicenses, ComponentLicenseModel{
+			LicenseId:   types.StringPointerValue(license.LicenseId),
+			LicenseName: types.StringPointerValue(license.LicenseName),
+		})
+	}
+	m.EffectiveLicenses = make([]ComponentLicenseModel, 0)
+	for _, license := range licenseData.EffectiveLicenses {
+		m.EffectiveLicenses = append(m.EffectiveLicenses, ComponentLicenseModel{
+			LicenseId:   types.StringPointerValue(license.LicenseId),
+			LicenseName: types.StringPointerValue(license.LicenseName),
+		})
+	}
+
+	securityData := api.GetSecurityData()
+	m.SecurityIssues = make([]ComponentSecurityIssueModel, 0)
+	for _, issue := range securityData.SecurityIssues {
+		si := ComponentSecurityIssueModel{}
+		si.MapFromApi(&issue)
+		m.SecurityIssues = append(m.SecurityIssues, si)
+	}
+}
+
+// ComponentLicenseModel
+// ------------------------------------------------------------
+type ComponentLicenseModel struct {
+	LicenseId   types.String `tfsdk:"license_id"`
+	LicenseName types.String `tfsdk:"license_name"`
+}
+
+// ComponentSecurityIssueModel
+// ------------------------------------------------------------
+type ComponentSecurityIssueModel struct {
+	Reference      types.String  `tfsdk:"reference"`
+	Source         types.String  `tfsdk:"source"`
+	Severity       types.Float64 `tfsdk:"severity"`
+	ThreatCategory types.String  `tfsdk:"threat_category"`
+	Status         types.String  `tfsdk:"status"`
+	CvssVector     types.String  `tfsdk:"cvss_vector"`
+	Cwe            types.String  `tfsdk:"cwe"`
+	Url            types.String  `tfsdk:"url"`
+}
+
+func (m *ComponentSecurityIssueModel) MapFromApi(api *sonatypeiq.ApiSecurityIssueDTO) {
+	m.Reference = types.StringPointerValue(api.Reference)
+	m.Source = types.StringPointerValue(api.Source)
+	m.Severity = float32Value(api.Severity)
+	m.ThreatCategory = types.StringPointerValue(api.ThreatCategory)
+	m.Status = types.StringPointerValue(api.Status)
+	m.CvssVector = types.StringPointerValue(api.CvssVector)
+	m.Cwe = types.StringPointerValue(api.Cwe)
+	m.Url = types.StringPointerValue(api.Url)
+}
+
+// float32Value converts an API float32 to a Float64 without picking up binary noise (e.g. 9.8 not 9.800000190734863)
+func float32Value(v *float32) types.Float64 {
+	if v == nil {
+		return types.Float64Null()
+	}
+	f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(*v), 'f', -1, 32), 64)
+	return types.Float64Value(f)
+}
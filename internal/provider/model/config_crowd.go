@@ -23,20 +23,28 @@ import (
 	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
 )
 
-type ConfigCrowdModel struct {
-	ID                  types.String `tfsdk:"id"`
-	ServerUrl           types.String `tfsdk:"server_url"`
-	ApplicationName     types.String `tfsdk:"application_name"`
-	ApplicationPassword types.String `tfsdk:"application_password"`
-	LastUpdated         types.String `tfsdk:"last_updated"`
+// ConfigCrowdDataSourceModel
+// ------------------------------------------------------------
+type ConfigCrowdDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ServerUrl       types.String `tfsdk:"server_url"`
+	ApplicationName types.String `tfsdk:"application_name"`
 }
 
-func (m *ConfigCrowdModel) MapFromApi(api *sonatypeiq.ApiCrowdConfigurationDTO) {
+func (m *ConfigCrowdDataSourceModel) MapFromApi(api *sonatypeiq.ApiCrowdConfigurationDTO) {
 	m.ID = types.StringValue(common.STATE_ID_CROWD_CONFIGURATION)
 	m.ServerUrl = types.StringPointerValue(api.ServerUrl)
 	m.ApplicationName = types.StringPointerValue(api.ApplicationName)
 }
 
+// ConfigCrowdModel
+// ------------------------------------------------------------
+type ConfigCrowdModel struct {
+	ConfigCrowdDataSourceModel
+	ApplicationPassword types.String `tfsdk:"application_password"`
+	LastUpdated         types.String `tfsdk:"last_updated"`
+}
+
 func (m *ConfigCrowdModel) MapToApi() *sonatypeiq.ApiCrowdConfigurationDTO {
 	api := sonatypeiq.NewApiCrowdConfigurationDTOWithDefaults()
 	api.ServerUrl = m.ServerUrl.ValueStringPointer()
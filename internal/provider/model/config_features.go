@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ConfigFeaturesModel
+// ------------------------------------------------------------
+type ConfigFeaturesModel struct {
+	ID          types.String `tfsdk:"id"`
+	Features    types.Map    `tfsdk:"features"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// MapFromApi updates the managed Features with their state on the server - Features not
+// managed by Terraform, or not reported by the server, are left untouched. After import
+// every Feature reported by the server is taken.
+func (m *ConfigFeaturesModel) MapFromApi(ctx context.Context, api map[string]bool) {
+	m.ID = types.StringValue(common.STATE_ID_FEATURE_CONFIGURATION)
+	if m.Features.IsNull() {
+		m.Features, _ = types.MapValueFrom(ctx, types.BoolType, api)
+		return
+	}
+	features := m.FeaturesAsMap(ctx)
+	for feature := range features {
+		if enabled, ok := api[feature]; ok {
+			features[feature] = enabled
+		}
+	}
+	m.Features, _ = types.MapValueFrom(ctx, types.BoolType, features)
+}
+
+func (m *ConfigFeaturesModel) FeaturesAsMap(ctx context.Context) map[string]bool {
+	features := make(map[string]bool)
+	m.Features.ElementsAs(ctx, &features, false)
+	return features
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"terraform-provider-sonatypeiq/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+type ConfigJiraModel struct {
+	ID              types.String `tfsdk:"id"`
+	Url             types.String `tfsdk:"url"`
+	Username        types.String `tfsdk:"username"`
+	ApiToken        types.String `tfsdk:"api_token"`
+	ApiTokenVersion types.Int32  `tfsdk:"api_token_version"`
+	LastUpdated     types.String `tfsdk:"last_updated"`
+}
+
+func (m *ConfigJiraModel) MapFromApi(api *sonatypeiq.ApiJiraConfigurationDTO) {
+	m.ID = types.StringValue(common.STATE_ID_JIRA_CONFIGURATION)
+	m.Url = types.StringPointerValue(api.Url)
+	m.Username = types.StringPointerValue(api.Username)
+	// API Token is write-only and never returned by API
+	m.ApiToken = types.StringNull()
+}
+
+// MapToApi takes the API Token from configuration as it is never held in Plan or State
+func (m *ConfigJiraModel) MapToApi(apiToken types.String) *sonatypeiq.ApiJiraConfigurationDTO {
+	api := sonatypeiq.NewApiJiraConfigurationDTOWithDefaults()
+	api.Url = m.Url.ValueStringPointer()
+	api.Username = m.Username.ValueStringPointer()
+	api.Password = apiToken.ValueStringPointer()
+	return api
+}
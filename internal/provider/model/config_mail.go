@@ -23,19 +23,19 @@ import (
 	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
 )
 
-type ConfigMailModel struct {
+// ConfigMailDataSourceModel
+// ------------------------------------------------------------
+type ConfigMailDataSourceModel struct {
 	ID              types.String `tfsdk:"id"`
 	Hostname        types.String `tfsdk:"hostname"`
 	Port            types.Int32  `tfsdk:"port"`
 	Username        types.String `tfsdk:"username"`
-	Password        types.String `tfsdk:"password"`
 	SSLEnabled      types.Bool   `tfsdk:"ssl_enabled"`
 	StartTLSEnabled types.Bool   `tfsdk:"start_tls_enabled"`
 	SystemEmail     types.String `tfsdk:"system_email"`
-	LastUpdated     types.String `tfsdk:"last_updated"`
 }
 
-func (m *ConfigMailModel) MapFromApi(api *sonatypeiq.ApiMailConfigurationDTO) {
+func (m *ConfigMailDataSourceModel) MapFromApi(api *sonatypeiq.ApiMailConfigurationDTO) {
 	m.ID = types.StringValue(common.STATE_ID_MAIL_CONFIGURATION)
 	m.Hostname = types.StringPointerValue(api.Hostname)
 	m.Port = types.Int32PointerValue(api.Port)
@@ -46,6 +46,14 @@ func (m *ConfigMailModel) MapFromApi(api *sonatypeiq.ApiMailConfigurationDTO) {
 	m.SystemEmail = types.StringPointerValue(api.SystemEmail)
 }
 
+// ConfigMailModel
+// ------------------------------------------------------------
+type ConfigMailModel struct {
+	ConfigMailDataSourceModel
+	Password    types.String `tfsdk:"password"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
 func (m *ConfigMailModel) MapToApi() *sonatypeiq.ApiMailConfigurationDTO {
 	api := sonatypeiq.NewApiMailConfigurationDTOWithDefaults()
 	api.Hostname = m.Hostname.ValueStringPointer()
@@ -24,17 +24,17 @@ import (
 	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
 )
 
-type ConfigProxyModel struct {
+// ConfigProxyDataSourceModel
+// ------------------------------------------------------------
+type ConfigProxyDataSourceModel struct {
 	ID           types.String `tfsdk:"id"`
 	Hostname     types.String `tfsdk:"hostname"`
 	Port         types.Int32  `tfsdk:"port"`
 	Username     types.String `tfsdk:"username"`
-	Password     types.String `tfsdk:"password"`
 	ExcludeHosts types.Set    `tfsdk:"exclude_hosts"`
-	LastUpdated  types.String `tfsdk:"last_updated"`
 }
 
-func (m *ConfigProxyModel) MapFromApi(ctx context.Context, api *sonatypeiq.ApiProxyServerConfigurationDTO) {
+func (m *ConfigProxyDataSourceModel) MapFromApi(ctx context.Context, api *sonatypeiq.ApiProxyServerConfigurationDTO) {
 	m.ID = types.StringValue(common.STATE_ID_PROXY_CONFIGURATION)
 	m.Hostname = types.StringPointerValue(api.Hostname)
 	m.Port = types.Int32PointerValue(api.Port)
@@ -45,6 +45,14 @@ func (m *ConfigProxyModel) MapFromApi(ctx context.Context, api *sonatypeiq.ApiPr
 	m.ExcludeHosts, _ = types.SetValueFrom(ctx, types.StringType, excludeHosts)
 }
 
+// ConfigProxyModel
+// ------------------------------------------------------------
+type ConfigProxyModel struct {
+	ConfigProxyDataSourceModel
+	Password    types.String `tfsdk:"password"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
 func (m *ConfigProxyModel) MapToApi(ctx context.Context) *sonatypeiq.ApiProxyServerConfigurationDTO {
 	api := sonatypeiq.NewApiProxyServerConfigurationDTOWithDefaults()
 	api.Hostname = m.Hostname.ValueStringPointer()
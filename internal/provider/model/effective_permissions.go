@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EffectivePermissionsModel
+// ------------------------------------------------------------
+type EffectivePermissionsModel struct {
+	ID          types.String              `tfsdk:"id"`
+	OwnerType   types.String              `tfsdk:"owner_type"`
+	OwnerId     types.String              `tfsdk:"owner_id"`
+	UserName    types.String              `tfsdk:"user_name"`
+	GroupName   types.String              `tfsdk:"group_name"`
+	Permissions types.Set                 `tfsdk:"permissions"`
+	Roles       []EffectiveRoleGrantModel `tfsdk:"roles"`
+}
+
+// MapFromApi sets the effective Permission IDs and the Role grants they come from
+func (m *EffectivePermissionsModel) MapFromApi(ctx context.Context, permissionIds []string, grants []EffectiveRoleGrantModel) {
+	memberType, memberName := common.MEMBER_TYPE_USER, m.UserName.ValueString()
+	if !m.GroupName.IsNull() {
+		memberType, memberName = common.MEMBER_TYPE_GROUP, m.GroupName.ValueString()
+	}
+	m.ID = types.StringValue(m.OwnerType.ValueString() + "-" + m.OwnerId.ValueString() + "-" + memberType + "-" + memberName)
+	m.Permissions, _ = types.SetValueFrom(ctx, types.StringType, permissionIds)
+	m.Roles = grants
+}
+
+// EffectiveRoleGrantModel
+// ------------------------------------------------------------
+type EffectiveRoleGrantModel struct {
+	RoleId    types.String `tfsdk:"role_id"`
+	RoleName  types.String `tfsdk:"role_name"`
+	OwnerType types.String `tfsdk:"owner_type"`
+	OwnerId   types.String `tfsdk:"owner_id"`
+}
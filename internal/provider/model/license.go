@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// LicensesModel
+// ------------------------------------------------------------
+type LicensesModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Licenses []LicenseModel `tfsdk:"licenses"`
+}
+
+func (m *LicensesModel) MapFromApi(api []common.License) {
+	m.ID = types.StringValue("licenses")
+	m.Licenses = make([]LicenseModel, 0, len(api))
+	for _, license := range api {
+		m.Licenses = append(m.Licenses, LicenseModel{
+			ID:   types.StringValue(license.Id),
+			Name: stringValueOrNull(license.Name),
+		})
+	}
+}
+
+// LicenseModel
+// ------------------------------------------------------------
+type LicenseModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// LicenseThreatGroupsModel
+// ------------------------------------------------------------
+type LicenseThreatGroupsModel struct {
+	ID                  types.String              `tfsdk:"id"`
+	OrganizationId      types.String              `tfsdk:"organization_id"`
+	LicenseThreatGroups []LicenseThreatGroupModel `tfsdk:"license_threat_groups"`
+}
+
+func (m *LicenseThreatGroupsModel) MapFromApi(ctx context.Context, api []common.LicenseThreatGroup) {
+	m.ID = types.StringValue("license-threat-groups-" + m.OrganizationId.ValueString())
+	m.LicenseThreatGroups = make([]LicenseThreatGroupModel, 0, len(api))
+	for _, group := range api {
+		g := LicenseThreatGroupModel{}
+		g.MapFromApi(ctx, group)
+		m.LicenseThreatGroups = append(m.LicenseThreatGroups, g)
+	}
+}
+
+// LicenseThreatGroupModel
+// ------------------------------------------------------------
+type LicenseThreatGroupModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	ThreatLevel    types.Int32  `tfsdk:"threat_level"`
+	ThreatCategory types.String `tfsdk:"threat_category"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	LicenseIds     types.List   `tfsdk:"license_ids"`
+}
+
+func (m *LicenseThreatGroupModel) MapFromApi(ctx context.Context, api common.LicenseThreatGroup) {
+	m.ID = types.StringValue(api.Id)
+	m.Name = types.StringValue(api.Name)
+	m.ThreatLevel = types.Int32Value(api.ThreatLevel)
+	m.ThreatCategory = stringValueOrNull(api.ThreatCategory)
+	m.OrganizationId = stringValueOrNull(api.OwnerId)
+	m.LicenseIds, _ = types.ListValueFrom(ctx, types.StringType, api.LicenseIds)
+}
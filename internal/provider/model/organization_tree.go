@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+const organizationTreePathSeparator = "/"
+
+// OrganizationTreeModel
+// ------------------------------------------------------------
+type OrganizationTreeModel struct {
+	ID            types.String                `tfsdk:"id"`
+	Organizations []OrganizationTreeNodeModel `tfsdk:"organizations"`
+}
+
+// MapFromApi builds the tree of Organizations - Organizations are returned depth first, with the children of
+// each Organization ordered by name.
+func (m *OrganizationTreeModel) MapFromApi(ctx context.Context, orgs *sonatypeiq.ApiOrganizationListDTO, apps *sonatypeiq.ApiApplicationListDTO) {
+	m.ID = types.StringValue("organization-tree")
+
+	known := make(map[string]bool)
+	for _, org := range orgs.Organizations {
+		known[org.GetId()] = true
+	}
+
+	// Organizations whose parent is not visible are treated as roots of the tree
+	children := make(map[string][]sonatypeiq.ApiOrganizationDTO)
+	for _, org := range orgs.Organizations {
+		parentId := org.GetParentOrganizationId()
+		if !known[parentId] {
+			parentId = ""
+		}
+		children[parentId] = append(children[parentId], org)
+	}
+	for _, siblings := range children {
+		sort.SliceStable(siblings, func(i, j int) bool {
+			return siblings[i].GetName() < siblings[j].GetName()
+		})
+	}
+
+	applications := make(map[string][]string)
+	for _, app := range apps.Applications {
+		applications[app.GetOrganizationId()] = append(applications[app.GetOrganizationId()], app.GetId())
+	}
+
+	m.Organizations = make([]OrganizationTreeNodeModel, 0, len(orgs.Organizations))
+	var visit func(org sonatypeiq.ApiOrganizationDTO, path []string) []string
+	visit = func(org sonatypeiq.ApiOrganizationDTO, path []string) []string {
+		path = append(path, org.GetName())
+		index := len(m.Organizations)
+		m.Organizations = append(m.Organizations, OrganizationTreeNodeModel{})
+
+		childIds := make([]string, 0)
+		applicationIds := append(make([]string, 0), applications[org.GetId()]...)
+		for _, child := range children[org.GetId()] {
+			childIds = append(childIds, child.GetId())
+			applicationIds = append(applicationIds, visit(child, path)...)
+		}
+
+		m.Organizations[index].MapFromApi(ctx, &org, path, childIds, applicationIds)
+		return applicationIds
+	}
+	for _, root := range children[""] {
+		visit(root, make([]string, 0))
+	}
+}
+
+// OrganizationTreeNodeModel
+// ------------------------------------------------------------
+type OrganizationTreeNodeModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	ParentOrganizationId types.String `tfsdk:"parent_organization_id"`
+	Path                 types.String `tfsdk:"path"`
+	Depth                types.Int32  `tfsdk:"depth"`
+	ChildOrganizationIds types.List   `tfsdk:"child_organization_ids"`
+	ApplicationIds       types.List   `tfsdk:"application_ids"`
+}
+
+func (m *OrganizationTreeNodeModel) MapFromApi(ctx context.Context, api *sonatypeiq.ApiOrganizationDTO, path []string, childIds []string, applicationIds []string) {
+	m.ID = types.StringPointerValue(api.Id)
+	m.Name = types.StringPointerValue(api.Name)
+	m.ParentOrganizationId = types.StringPointerValue(api.ParentOrganizationId)
+	m.Path = types.StringValue(strings.Join(path, organizationTreePathSeparator))
+	m.Depth = types.Int32Value(int32(len(path) - 1))
+	m.ChildOrganizationIds, _ = types.ListValueFrom(ctx, types.StringType, childIds)
+	m.ApplicationIds, _ = types.ListValueFrom(ctx, types.StringType, applicationIds)
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// PolicyApplicationCategoriesModelResource
+// ------------------------------------------------------------
+type PolicyApplicationCategoriesModelResource struct {
+	ID             types.String `tfsdk:"id"`
+	PolicyId       types.String `tfsdk:"policy_id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	CategoryIds    types.Set    `tfsdk:"category_ids"`
+	LastUpdated    types.String `tfsdk:"last_updated"`
+}
+
+func (m *PolicyApplicationCategoriesModelResource) MapFromApi(ctx context.Context, api []sonatypeiq.PolicyTag) {
+	m.ID = types.StringValue(fmt.Sprintf("%s,%s", m.OrganizationId.ValueString(), m.PolicyId.ValueString()))
+	categoryIds := make([]string, 0)
+	for _, policyTag := range api {
+		if policyTag.GetPolicyId() == m.PolicyId.ValueString() {
+			categoryIds = append(categoryIds, policyTag.GetTagId())
+		}
+	}
+	m.CategoryIds, _ = types.SetValueFrom(ctx, types.StringType, categoryIds)
+}
+
+func (m *PolicyApplicationCategoriesModelResource) MapToApi(ctx context.Context) []sonatypeiq.PolicyTag {
+	var categoryIds []string
+	m.CategoryIds.ElementsAs(ctx, &categoryIds, false)
+	api := make([]sonatypeiq.PolicyTag, 0)
+	for _, categoryId := range categoryIds {
+		policyTag := sonatypeiq.NewPolicyTagWithDefaults()
+		policyTag.PolicyId = m.PolicyId.ValueStringPointer()
+		policyTag.TagId = sonatypeiq.PtrString(categoryId)
+		api = append(api, *policyTag)
+	}
+	return api
+}
+
+// PolicyBundleModelResource
+// ------------------------------------------------------------
+type PolicyBundleModelResource struct {
+	ID             types.String                 `tfsdk:"id"`
+	OrganizationId types.String                 `tfsdk:"organization_id"`
+	PolicyJson     common.PolicyBundleJsonValue `tfsdk:"policy_json"`
+	LastUpdated    types.String                 `tfsdk:"last_updated"`
+}
+
+// PoliciesModel
+// ------------------------------------------------------------
+type PoliciesModel struct {
+	ID             types.String  `tfsdk:"id"`
+	ApplicationId  types.String  `tfsdk:"application_id"`
+	OrganizationId types.String  `tfsdk:"organization_id"`
+	Policies       []PolicyModel `tfsdk:"policies"`
+}
+
+// PolicyModel
+// ------------------------------------------------------------
+type PolicyModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	PolicyType  types.String `tfsdk:"policy_type"`
+	ThreatLevel types.Int32  `tfsdk:"threat_level"`
+	OwnerId     types.String `tfsdk:"owner_id"`
+	OwnerType   types.String `tfsdk:"owner_type"`
+	Inherited   types.Bool   `tfsdk:"inherited"`
+	Actions     types.Map    `tfsdk:"actions"`
+}
+
+// MapFromApi maps a Policy and the action it takes at each stage - actions are empty if the Policy only records violations
+func (m *PolicyModel) MapFromApi(ctx context.Context, api *sonatypeiq.ApiPolicyDTO, inherited bool, actions map[string]string) {
+	m.ID = types.StringPointerValue(api.Id)
+	m.Name = types.StringPointerValue(api.Name)
+	m.PolicyType = types.StringPointerValue(api.PolicyType)
+	m.ThreatLevel = types.Int32PointerValue(api.ThreatLevel)
+	m.OwnerId = types.StringPointerValue(api.OwnerId)
+	m.OwnerType = types.StringPointerValue(api.OwnerType)
+	m.Inherited = types.BoolValue(inherited)
+	if actions == nil {
+		actions = make(map[string]string)
+	}
+	m.Actions, _ = types.MapValueFrom(ctx, types.StringType, actions)
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// PolicyWaiversModel
+// ------------------------------------------------------------
+type PolicyWaiversModel struct {
+	ID                 types.String        `tfsdk:"id"`
+	OwnerType          types.String        `tfsdk:"owner_type"`
+	OwnerId            types.String        `tfsdk:"owner_id"`
+	IncludeDescendants types.Bool          `tfsdk:"include_descendants"`
+	ExpiringWithinDays types.Int32         `tfsdk:"expiring_within_days"`
+	Waivers            []PolicyWaiverModel `tfsdk:"waivers"`
+}
+
+func (m *PolicyWaiversModel) MapFromApi(api []sonatypeiq.ApiPolicyWaiverDTO) {
+	m.ID = types.StringValue("policy-waivers-" + m.OwnerType.ValueString() + "-" + m.OwnerId.ValueString())
+	m.Waivers = make([]PolicyWaiverModel, 0, len(api))
+	for _, waiver := range api {
+		w := PolicyWaiverModel{}
+		w.MapFromApi(&waiver)
+		m.Waivers = append(m.Waivers, w)
+	}
+}
+
+// PolicyWaiverModel
+// ------------------------------------------------------------
+type PolicyWaiverModel struct {
+	ID                             types.String `tfsdk:"id"`
+	PolicyId                       types.String `tfsdk:"policy_id"`
+	PolicyName                     types.String `tfsdk:"policy_name"`
+	ThreatLevel                    types.Int32  `tfsdk:"threat_level"`
+	ScopeOwnerType                 types.String `tfsdk:"scope_owner_type"`
+	ScopeOwnerId                   types.String `tfsdk:"scope_owner_id"`
+	ScopeOwnerName                 types.String `tfsdk:"scope_owner_name"`
+	Comment                        types.String `tfsdk:"comment"`
+	Reason                         types.String `tfsdk:"reason"`
+	CreatorId                      types.String `tfsdk:"creator_id"`
+	CreatorName                    types.String `tfsdk:"creator_name"`
+	CreateTime                     types.String `tfsdk:"create_time"`
+	ExpiryTime                     types.String `tfsdk:"expiry_time"`
+	ExpireWhenRemediationAvailable types.Bool   `tfsdk:"expire_when_remediation_available"`
+	ComponentName                  types.String `tfsdk:"component_name"`
+	PackageUrl                     types.String `tfsdk:"package_url"`
+	Hash                           types.String `tfsdk:"hash"`
+	MatcherStrategy                types.String `tfsdk:"matcher_strategy"`
+	VulnerabilityId                types.String `tfsdk:"vulnerability_id"`
+}
+
+func (m *PolicyWaiverModel) MapFromApi(api *sonatypeiq.ApiPolicyWaiverDTO) {
+	m.ID = types.StringPointerValue(api.PolicyWaiverId)
+	m.PolicyId = types.StringPointerValue(api.PolicyId)
+	m.PolicyName = types.StringPointerValue(api.PolicyName)
+	m.ThreatLevel = types.Int32PointerValue(api.ThreatLevel)
+	m.ScopeOwnerType = types.StringPointerValue(api.ScopeOwnerType)
+	m.ScopeOwnerId = types.StringPointerValue(api.ScopeOwnerId)
+	m.ScopeOwnerName = types.StringPointerValue(api.ScopeOwnerName)
+	m.Comment = types.StringPointerValue(api.Comment)
+	m.Reason = types.StringPointerValue(api.ReasonText)
+	m.CreatorId = types.StringPointerValue(api.CreatorId)
+	m.CreatorName = types.StringPointerValue(api.CreatorName)
+	m.CreateTime = timeValueOrNull(api.CreateTime)
+	m.ExpiryTime = timeValueOrNull(api.ExpiryTime)
+	m.ExpireWhenRemediationAvailable = types.BoolPointerValue(api.ExpireWhenRemediationAvailable)
+	m.ComponentName = types.StringPointerValue(api.ComponentName)
+	if m.ComponentName.IsNull() && api.DisplayName != nil {
+		m.ComponentName = types.StringPointerValue(api.DisplayName.Name)
+	}
+	m.PackageUrl = types.StringPointerValue(api.AssociatedPackageUrl)
+	m.Hash = types.StringPointerValue(api.Hash)
+	m.MatcherStrategy = types.StringPointerValue(api.MatcherStrategy)
+	m.VulnerabilityId = types.StringPointerValue(api.VulnerabilityId)
+}
+
+func timeValueOrNull(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
@@ -17,9 +17,37 @@
 package model
 
 import (
+	"context"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
 )
 
+// ProductLicenseModel
+// -----------------------------------
+type ProductLicenseModel struct {
+	ID               types.String `tfsdk:"id"`
+	LicensedProducts types.List   `tfsdk:"licensed_products"`
+	ExpiryDate       types.String `tfsdk:"expiry_date"`
+	LicensedUsers    types.Int32  `tfsdk:"licensed_users"`
+	ActiveUsers      types.Int32  `tfsdk:"active_users"`
+}
+
+func (m *ProductLicenseModel) MapFromApi(ctx context.Context, solutions []sonatypeiq.ApiLicensedSolutionDTO, details *common.ProductLicenseDetails) {
+	m.ID = types.StringValue(common.STATE_ID_IQ_PRODUCT_LICENSE)
+
+	m.LicensedProducts, _ = types.ListValueFrom(ctx, types.StringType, licensedProducts(solutions))
+
+	m.ExpiryDate = types.StringNull()
+	if details.ExpiryTimestamp != nil {
+		m.ExpiryDate = types.StringValue(time.UnixMilli(*details.ExpiryTimestamp).UTC().Format(time.RFC3339))
+	}
+	m.LicensedUsers = types.Int32PointerValue(details.LicensedUsers)
+	m.ActiveUsers = types.Int32PointerValue(details.ActiveUsers)
+}
+
 // ProductLicenseModelResource
 // -----------------------------------
 type ProductLicenseModelResource struct {
@@ -31,3 +59,11 @@ type ProductLicenseModelResource struct {
 type ProductLicenseCreateModel struct {
 	LicenseData types.String `tfsdk:"license_data"`
 }
+
+func licensedProducts(solutions []sonatypeiq.ApiLicensedSolutionDTO) []string {
+	products := make([]string, 0)
+	for _, solution := range solutions {
+		products = append(products, solution.GetId())
+	}
+	return products
+}
@@ -45,6 +45,13 @@ func (m *RoleModel) MapFromApi(api *sonatypeiq.ApiRoleDTO) {
 	m.Permissions.MapFromApi(api.PermissionCategories)
 }
 
+// RolesModel
+// ------------------------------------------------------------
+type RolesModel struct {
+	ID    types.String `tfsdk:"id"`
+	Roles []RoleModel  `tfsdk:"roles"`
+}
+
 // RoleModelResource
 // ------------------------------------------------------------
 type RoleModelResource struct {
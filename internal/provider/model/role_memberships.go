@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// RoleMembershipsModel
+// ------------------------------------------------------------
+type RoleMembershipsModel struct {
+	ID          types.String          `tfsdk:"id"`
+	OwnerType   types.String          `tfsdk:"owner_type"`
+	OwnerId     types.String          `tfsdk:"owner_id"`
+	Memberships []RoleMembershipModel `tfsdk:"memberships"`
+}
+
+// MapFromApi maps the Role memberships for the owner, using roleNames to name each Role
+func (m *RoleMembershipsModel) MapFromApi(api *sonatypeiq.ApiRoleMemberMappingListDTO, roleNames map[string]string) {
+	m.ID = types.StringValue(m.OwnerType.ValueString() + "-" + m.OwnerId.ValueString())
+	m.Memberships = make([]RoleMembershipModel, 0)
+	for _, mapping := range api.MemberMappings {
+		membership := RoleMembershipModel{
+			RoleId:   types.StringPointerValue(mapping.RoleId),
+			RoleName: types.StringNull(),
+			Members:  make([]RoleMemberModel, 0),
+		}
+		if name, ok := roleNames[mapping.GetRoleId()]; ok {
+			membership.RoleName = types.StringValue(name)
+		}
+		for _, apiMember := range mapping.Members {
+			member := RoleMemberModel{}
+			member.MapFromApi(&apiMember, m.OwnerType.ValueString(), m.OwnerId.ValueString())
+			membership.Members = append(membership.Members, member)
+		}
+		m.Memberships = append(m.Memberships, membership)
+	}
+}
+
+// RoleMembershipModel
+// ------------------------------------------------------------
+type RoleMembershipModel struct {
+	RoleId   types.String      `tfsdk:"role_id"`
+	RoleName types.String      `tfsdk:"role_name"`
+	Members  []RoleMemberModel `tfsdk:"members"`
+}
+
+// RoleMemberModel
+// ------------------------------------------------------------
+type RoleMemberModel struct {
+	Type      types.String `tfsdk:"type"`
+	Name      types.String `tfsdk:"name"`
+	OwnerType types.String `tfsdk:"owner_type"`
+	OwnerId   types.String `tfsdk:"owner_id"`
+	Inherited types.Bool   `tfsdk:"inherited"`
+}
+
+func (m *RoleMemberModel) MapFromApi(api *sonatypeiq.ApiMemberDTO, ownerType, ownerId string) {
+	m.Type = types.StringValue(strings.ToLower(api.GetType()))
+	m.Name = types.StringPointerValue(api.UserOrGroupName)
+	m.OwnerType = types.StringValue(strings.ToLower(api.GetOwnerType()))
+	m.OwnerId = types.StringPointerValue(api.OwnerId)
+	m.Inherited = types.BoolValue(strings.ToLower(api.GetOwnerType()) != ownerType || api.GetOwnerId() != ownerId)
+}
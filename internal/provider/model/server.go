@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// ServerModel
+// ------------------------------------------------------------
+type ServerModel struct {
+	ID               types.String `tfsdk:"id"`
+	BaseUrl          types.String `tfsdk:"base_url"`
+	Version          types.String `tfsdk:"version"`
+	VersionMajor     types.Int32  `tfsdk:"version_major"`
+	VersionMinor     types.Int32  `tfsdk:"version_minor"`
+	VersionPatch     types.Int32  `tfsdk:"version_patch"`
+	VersionBuild     types.Int32  `tfsdk:"version_build"`
+	LicensedProducts types.List   `tfsdk:"licensed_products"`
+}
+
+func (m *ServerModel) MapFromApi(ctx context.Context, baseUrl string, serverVersion common.ServerVersion, solutions []sonatypeiq.ApiLicensedSolutionDTO) {
+	m.ID = types.StringValue("server")
+	m.BaseUrl = types.StringValue(baseUrl)
+	m.Version = types.StringValue(serverVersion.String())
+	m.VersionMajor = types.Int32Value(serverVersion.Major)
+	m.VersionMinor = types.Int32Value(serverVersion.Minor)
+	m.VersionPatch = types.Int32Value(serverVersion.Patch)
+	m.VersionBuild = types.Int32Value(serverVersion.Build)
+	m.LicensedProducts, _ = types.ListValueFrom(ctx, types.StringType, licensedProducts(solutions))
+}
@@ -75,3 +75,79 @@ func (m *SourceControlModelResource) MapToApi() *sonatypeiq.ApiSourceControlDTO
 
 	return api
 }
+
+// SourceControlModel
+// --------------------------------------------
+type SourceControlModel struct {
+	ID                              types.String                    `tfsdk:"id"`
+	OwnerID                         types.String                    `tfsdk:"owner_id"`
+	OwnerType                       types.String                    `tfsdk:"owner_type"`
+	RepositoryUrl                   types.String                    `tfsdk:"repository_url"`
+	ScmProvider                     SourceControlStringSettingModel `tfsdk:"scm_provider"`
+	BaseBranch                      SourceControlStringSettingModel `tfsdk:"base_branch"`
+	UserName                        SourceControlStringSettingModel `tfsdk:"user_name"`
+	RemediationPullRequestsEnabled  SourceControlBoolSettingModel   `tfsdk:"remediation_pull_requests_enabled"`
+	PullRequestCommentingEnabled    SourceControlBoolSettingModel   `tfsdk:"pull_request_commenting_enabled"`
+	SourceControlEvaluationsEnabled SourceControlBoolSettingModel   `tfsdk:"source_control_evaluation_enabled"`
+	CommitStatusEnabled             SourceControlBoolSettingModel   `tfsdk:"commit_status_enabled"`
+}
+
+func (m *SourceControlModel) MapFromApi(api *sonatypeiq.ApiCompositeSourceControlDTO) {
+	m.ID = types.StringValue(fmt.Sprintf(common.SCM_CONFIG_ID_FORMAT, m.OwnerType.ValueString(), m.OwnerID.ValueString()))
+	m.RepositoryUrl = types.StringPointerValue(api.RepositoryUrl)
+	m.ScmProvider.MapFromApi(api.Provider)
+	m.BaseBranch.MapFromApi(api.BaseBranch)
+	m.UserName.MapFromApi(api.Username)
+	m.RemediationPullRequestsEnabled.MapFromApi(api.RemediationPullRequestsEnabled)
+	m.PullRequestCommentingEnabled.MapFromApi(api.PullRequestCommentingEnabled)
+	m.SourceControlEvaluationsEnabled.MapFromApi(api.SourceControlEvaluationsEnabled)
+	m.CommitStatusEnabled.MapFromApi(api.CommitStatusEnabled)
+}
+
+// SourceControlStringSettingModel
+// --------------------------------------------
+type SourceControlStringSettingModel struct {
+	Value         types.String `tfsdk:"value"`
+	Inherited     types.Bool   `tfsdk:"inherited"`
+	InheritedFrom types.String `tfsdk:"inherited_from"`
+}
+
+func (m *SourceControlStringSettingModel) MapFromApi(api *sonatypeiq.ApiCompositeValueDTOString) {
+	m.Value = types.StringNull()
+	m.Inherited = types.BoolValue(false)
+	m.InheritedFrom = types.StringNull()
+	if api == nil {
+		return
+	}
+	if api.Value != nil {
+		m.Value = types.StringPointerValue(api.Value)
+	} else if api.ParentValue != nil {
+		m.Value = types.StringPointerValue(api.ParentValue)
+		m.Inherited = types.BoolValue(true)
+		m.InheritedFrom = types.StringPointerValue(api.ParentName)
+	}
+}
+
+// SourceControlBoolSettingModel
+// --------------------------------------------
+type SourceControlBoolSettingModel struct {
+	Value         types.Bool   `tfsdk:"value"`
+	Inherited     types.Bool   `tfsdk:"inherited"`
+	InheritedFrom types.String `tfsdk:"inherited_from"`
+}
+
+func (m *SourceControlBoolSettingModel) MapFromApi(api *sonatypeiq.ApiCompositeValueDTOBoolean) {
+	m.Value = types.BoolNull()
+	m.Inherited = types.BoolValue(false)
+	m.InheritedFrom = types.StringNull()
+	if api == nil {
+		return
+	}
+	if api.Value != nil {
+		m.Value = types.BoolPointerValue(api.Value)
+	} else if api.ParentValue != nil {
+		m.Value = types.BoolPointerValue(api.ParentValue)
+		m.Inherited = types.BoolValue(true)
+		m.InheritedFrom = types.StringPointerValue(api.ParentName)
+	}
+}
@@ -59,3 +59,41 @@ func (m *UserModel) MapFromApi(api *sonatypeiq.ApiUserDTO) {
 	m.Email = types.StringPointerValue(api.Email)
 	m.Realm = types.StringPointerValue(api.Realm)
 }
+
+// UserDataSourceModel
+// ------------------------------------------------------------
+type UserDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Username  types.String `tfsdk:"username"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Email     types.String `tfsdk:"email"`
+	Realm     types.String `tfsdk:"realm"`
+}
+
+func (m *UserDataSourceModel) MapFromApi(api *sonatypeiq.ApiUserDTO) {
+	m.ID = types.StringValue(fmt.Sprintf(common.USER_ID_FORMAT, api.GetRealm(), api.GetUsername()))
+	m.Username = types.StringPointerValue(api.Username)
+	m.FirstName = types.StringPointerValue(api.FirstName)
+	m.LastName = types.StringPointerValue(api.LastName)
+	m.Email = types.StringPointerValue(api.Email)
+	m.Realm = types.StringPointerValue(api.Realm)
+}
+
+// UsersModel
+// ------------------------------------------------------------
+type UsersModel struct {
+	ID     types.String          `tfsdk:"id"`
+	Realm  types.String          `tfsdk:"realm"`
+	Search types.String          `tfsdk:"search"`
+	Users  []UserDataSourceModel `tfsdk:"users"`
+}
+
+func (m *UsersModel) MapFromApi(api []sonatypeiq.ApiUserDTO) {
+	m.Users = make([]UserDataSourceModel, 0)
+	for _, apiUser := range api {
+		user := UserDataSourceModel{}
+		user.MapFromApi(&apiUser)
+		m.Users = append(m.Users, user)
+	}
+}
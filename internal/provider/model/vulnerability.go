@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+)
+
+// VulnerabilityModel
+// ------------------------------------------------------------
+type VulnerabilityModel struct {
+	ID                      types.String                 `tfsdk:"id"`
+	Identifier              types.String                 `tfsdk:"identifier"`
+	Description             types.String                 `tfsdk:"description"`
+	Source                  types.String                 `tfsdk:"source"`
+	VulnerabilityLink       types.String                 `tfsdk:"vulnerability_link"`
+	VulnerabilityIds        types.List                   `tfsdk:"vulnerability_ids"`
+	MainSeverity            *VulnerabilitySeverityModel  `tfsdk:"main_severity"`
+	SeverityScores          []VulnerabilitySeverityModel `tfsdk:"severity_scores"`
+	CweIds                  types.List                   `tfsdk:"cwe_ids"`
+	Advisories              []VulnerabilityAdvisoryModel `tfsdk:"advisories"`
+	VulnerableVersionRanges types.List                   `tfsdk:"vulnerable_version_ranges"`
+}
+
+func (m *VulnerabilityModel) MapFromApi(ctx context.Context, api *sonatypeiq.SecurityVulnerabilityDataDTO) {
+	m.ID = types.StringPointerValue(api.Identifier)
+	m.Description = types.StringPointerValue(api.Description)
+	source := api.GetSource()
+	m.Source = types.StringPointerValue(source.ShortName)
+	m.VulnerabilityLink = types.StringPointerValue(api.VulnerabilityLink)
+	m.VulnerabilityIds, _ = types.ListValueFrom(ctx, types.StringType, nonNilStrings(api.VulnIds))
+
+	m.MainSeverity = nil
+	if api.MainSeverity != nil {
+		m.MainSeverity = &VulnerabilitySeverityModel{}
+		m.MainSeverity.MapFromApi(api.MainSeverity)
+	}
+	m.SeverityScores = make([]VulnerabilitySeverityModel, 0)
+	for _, score := range api.SeverityScores {
+		s := VulnerabilitySeverityModel{}
+		s.MapFromApi(&score)
+		m.SeverityScores = append(m.SeverityScores, s)
+	}
+
+	cweIds := make([]string, 0)
+	weakness := api.GetWeakness()
+	for _, cwe := range weakness.CweIds {
+		cweIds = append(cweIds, cwe.GetId())
+	}
+	m.CweIds, _ = types.ListValueFrom(ctx, types.StringType, cweIds)
+
+	m.Advisories = make([]VulnerabilityAdvisoryModel, 0)
+	for _, advisory := range api.Advisories {
+		m.Advisories = append(m.Advisories, VulnerabilityAdvisoryModel{
+			ReferenceType: types.StringPointerValue(advisory.ReferenceType),
+			Url:           types.StringPointerValue(advisory.Url),
+		})
+	}
+	m.VulnerableVersionRanges, _ = types.ListValueFrom(ctx, types.StringType, nonNilStrings(api.VulnerableVersionRanges))
+}
+
+// VulnerabilitySeverityModel
+// ------------------------------------------------------------
+type VulnerabilitySeverityModel struct {
+	Source      types.String  `tfsdk:"source"`
+	SourceLabel types.String  `tfsdk:"source_label"`
+	Score       types.Float64 `tfsdk:"score"`
+	Vector      types.String  `tfsdk:"vector"`
+}
+
+func (m *VulnerabilitySeverityModel) MapFromApi(api *sonatypeiq.SecurityVulnerabilitySeverityDTO) {
+	m.Source = types.StringPointerValue(api.Source)
+	m.SourceLabel = types.StringPointerValue(api.SourceLabel)
+	m.Score = float32Value(api.Score)
+	m.Vector = types.StringPointerValue(api.Vector)
+}
+
+// VulnerabilityAdvisoryModel
+// ------------------------------------------------------------
+type VulnerabilityAdvisoryModel struct {
+	ReferenceType types.String `tfsdk:"reference_type"`
+	Url           types.String `tfsdk:"url"`
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return make([]string, 0)
+	}
+	return values
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package organization
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &organizationTreeDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationTreeDataSource{}
+)
+
+// OrganizationTreeDataSource is a helper function to simplify the provider implementation.
+func OrganizationTreeDataSource() datasource.DataSource {
+	return &organizationTreeDataSource{}
+}
+
+// organizationTreeDataSource is the data source implementation.
+type organizationTreeDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *organizationTreeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_tree"
+}
+
+// Schema defines the schema for the data source.
+func (d *organizationTreeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get the hierarchy of all Organizations, with the Applications beneath each Organization",
+		Attributes: map[string]tfschema.Attribute{
+			"id": schema.DataSourceComputedString("The ID of this resource."),
+			"organizations": schema.DataSourceComputedListNestedAttribute(
+				"List of Organizations, depth first from the Root Organization with the children of each Organization ordered by name",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"id":                     schema.DataSourceComputedString("Internal ID of the Organization"),
+						"name":                   schema.DataSourceComputedString("Name of the Organization"),
+						"parent_organization_id": schema.DataSourceComputedString("Internal ID of the Organization to which this Organization belongs"),
+						"path":                   schema.DataSourceComputedString("Names of the Organizations from the Root Organization to this Organization, separated by `/`"),
+						"depth":                  schema.DataSourceComputedInt32("Depth of the Organization in the hierarchy - the Root Organization has depth 0"),
+						"child_organization_ids": schema.DataSourceComputedStringList("Internal IDs of the Organizations that directly belong to this Organization"),
+						"application_ids":        schema.DataSourceComputedStringList("Internal IDs of all Applications in this Organization or any Organization beneath it"),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *organizationTreeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.OrganizationTreeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	orgsResponse, httpResponse, err := d.Client.OrganizationsAPI.GetOrganizations(d.AuthContext(ctx)).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_ORGANIZATIONS,
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	appsResponse, httpResponse, err := d.Client.ApplicationsAPI.GetApplications(d.AuthContext(ctx)).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_APPLICATIONS,
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	// Map api response to State
+	data.MapFromApi(ctx, orgsResponse, appsResponse)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
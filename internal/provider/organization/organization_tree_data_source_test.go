@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package organization_test
+
+import (
+	"fmt"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationTreeDataSource(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceName := "data.sonatypeiq_organization_tree.tree"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccOrganizationTreeDataSource(randomStr),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "organization-tree"),
+					// ROOT ORG
+					resource.TestCheckResourceAttr(resourceName, "organizations.0.id", common.ROOT_ORGANIZATION_ID),
+					resource.TestCheckResourceAttr(resourceName, "organizations.0.path", "Root Organization"),
+					resource.TestCheckResourceAttr(resourceName, "organizations.0.depth", "0"),
+					resource.TestCheckNoResourceAttr(resourceName, "organizations.0.parent_organization_id"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "organizations.*", map[string]string{
+						"name":                     fmt.Sprintf("tree-%s", randomStr),
+						"path":                     fmt.Sprintf("Root Organization/tree-%s", randomStr),
+						"depth":                    "1",
+						"child_organization_ids.#": "1",
+						"application_ids.#":        "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "organizations.*", map[string]string{
+						"name":                     fmt.Sprintf("branch-%s", randomStr),
+						"path":                     fmt.Sprintf("Root Organization/tree-%s/branch-%s", randomStr, randomStr),
+						"depth":                    "2",
+						"child_organization_ids.#": "0",
+						"application_ids.#":        "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationTreeDataSource(randomStr string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatypeiq_organization" "tree" {
+  name                   = "tree-%s"
+  parent_organization_id = "%s"
+}
+
+resource "sonatypeiq_organization" "branch" {
+  name                   = "branch-%s"
+  parent_organization_id = sonatypeiq_organization.tree.id
+}
+
+resource "sonatypeiq_application" "leaf" {
+  name              = "leaf-%s"
+  public_id         = "leaf-%s"
+  organization_id   = sonatypeiq_organization.branch.id
+  contact_user_name = "admin"
+}
+
+data "sonatypeiq_organization_tree" "tree" {
+  depends_on = [sonatypeiq_application.leaf]
+}`, randomStr, common.ROOT_ORGANIZATION_ID, randomStr, randomStr, randomStr)
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &licenseThreatGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &licenseThreatGroupsDataSource{}
+)
+
+// LicenseThreatGroupsDataSource is a helper function to simplify the provider implementation.
+func LicenseThreatGroupsDataSource() datasource.DataSource {
+	return &licenseThreatGroupsDataSource{}
+}
+
+// licenseThreatGroupsDataSource is the data source implementation.
+type licenseThreatGroupsDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *licenseThreatGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license_threat_groups"
+}
+
+// Schema defines the schema for the data source.
+func (d *licenseThreatGroupsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get the License Threat Groups defined for an Organization and the licenses in each.\n\n" +
+			"License Threat Groups are read from internal endpoints used by the IQ Server UI. They are not part of the supported IQ Server API and may change or be removed in any version of IQ Server.",
+		Attributes: map[string]tfschema.Attribute{
+			"id":              schema.DataSourceComputedString("The ID of this resource."),
+			"organization_id": schema.DataSourceRequiredString("Internal ID of the Organization - use `ROOT_ORGANIZATION_ID` for the Root Organization"),
+			"license_threat_groups": schema.DataSourceComputedListNestedAttribute(
+				"License Threat Groups defined for the Organization, highest threat level first",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"id":              schema.DataSourceComputedString("Internal ID of the License Threat Group"),
+						"name":            schema.DataSourceComputedString("Name of the License Threat Group"),
+						"threat_level":    schema.DataSourceComputedInt32("Threat level of the License Threat Group (0 - 10)"),
+						"threat_category": schema.DataSourceComputedString("Threat category of the License Threat Group - for example `copyleft`"),
+						"organization_id": schema.DataSourceComputedString("Internal ID of the Organization that defines the License Threat Group"),
+						"license_ids":     schema.DataSourceComputedStringList("IDs of the licenses in the License Threat Group - see the `sonatypeiq_licenses` data source"),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *licenseThreatGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.LicenseThreatGroupsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	groups, httpResponse, err := common.ReadLicenseThreatGroups(ctx, d.Client, d.Auth, data.OrganizationId.ValueString())
+
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			d.handleNotFound(ctx, data.OrganizationId.ValueString(), &resp.Diagnostics)
+			return
+		}
+		errors.HandleAPIError(common.ERR_FAILED_READING_LICENSE_THREAT_GROUPS, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+
+	// Map api response to State
+	data.MapFromApi(ctx, groups)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// handleNotFound reports why License Threat Groups were not found - either the Organization does not exist, or the
+// internal endpoints are not available on the connected Sonatype IQ Server
+func (d *licenseThreatGroupsDataSource) handleNotFound(ctx context.Context, organizationId string, respDiags *diag.Diagnostics) {
+	_, httpResponse, err := d.Client.OrganizationsAPI.GetOrganization(d.AuthContext(ctx), organizationId).Execute()
+	if err != nil && httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+		errors.AddValidationDiagnostic(respDiags, "organization_id", fmt.Sprintf(common.ERR_ORGANIZATION_DID_NOT_EXIST, organizationId))
+		return
+	}
+
+	respDiags.AddError(
+		common.ERR_FAILED_READING_LICENSE_THREAT_GROUPS,
+		fmt.Sprintf(common.ERR_INTERNAL_ENDPOINT_NOT_SUPPORTED, "License Threat Groups", fmt.Sprintf(common.REST_PATH_LICENSE_THREAT_GROUPS, organizationId)),
+	)
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy_test
+
+import (
+	"fmt"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLicenseThreatGroupsDataSource(t *testing.T) {
+	dataSourceName := "data.sonatypeiq_license_threat_groups.root"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: utils_test.ProviderConfig + `data "sonatypeiq_license_threat_groups" "root" {
+					organization_id = "` + common.ROOT_ORGANIZATION_ID + `"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", fmt.Sprintf("license-threat-groups-%s", common.ROOT_ORGANIZATION_ID)),
+					resource.TestCheckResourceAttr(dataSourceName, "organization_id", common.ROOT_ORGANIZATION_ID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "license_threat_groups.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "license_threat_groups.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "license_threat_groups.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "license_threat_groups.0.threat_level"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "license_threat_groups.0.license_ids.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &licensesDataSource{}
+	_ datasource.DataSourceWithConfigure = &licensesDataSource{}
+)
+
+// LicensesDataSource is a helper function to simplify the provider implementation.
+func LicensesDataSource() datasource.DataSource {
+	return &licensesDataSource{}
+}
+
+// licensesDataSource is the data source implementation.
+type licensesDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *licensesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_licenses"
+}
+
+// Schema defines the schema for the data source.
+func (d *licensesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get all licenses known to Sonatype IQ Server.\n\n" +
+			"Licenses are read from an internal endpoint used by the IQ Server UI. It is not part of the supported IQ Server API and may change or be removed in any version of IQ Server.",
+		Attributes: map[string]tfschema.Attribute{
+			"id": schema.DataSourceComputedString("The ID of this resource."),
+			"licenses": schema.DataSourceComputedListNestedAttribute(
+				"Licenses known to Sonatype IQ Server, ordered by ID",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"id":   schema.DataSourceComputedString("ID of the license - for example `Apache-2.0`"),
+						"name": schema.DataSourceComputedString("Display name of the license"),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *licensesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.LicensesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	licenses, httpResponse, err := common.ReadLicenses(ctx, d.Client, d.Auth)
+
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				common.ERR_FAILED_READING_LICENSES,
+				fmt.Sprintf(common.ERR_INTERNAL_ENDPOINT_NOT_SUPPORTED, "Licenses", fmt.Sprintf(common.REST_PATH_LICENSES, common.ROOT_ORGANIZATION_ID)),
+			)
+			return
+		}
+		errors.HandleAPIError(common.ERR_FAILED_READING_LICENSES, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+
+	// Map api response to State
+	data.MapFromApi(licenses)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy_test
+
+import (
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLicensesDataSource(t *testing.T) {
+	dataSourceName := "data.sonatypeiq_licenses.all"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: utils_test.ProviderConfig + `data "sonatypeiq_licenses" "all" {
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "licenses"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "licenses.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "licenses.*", map[string]string{
+						"id": "Apache-2.0",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &policiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &policiesDataSource{}
+)
+
+// PoliciesDataSource is a helper function to simplify the provider implementation.
+func PoliciesDataSource() datasource.DataSource {
+	return &policiesDataSource{}
+}
+
+// policiesDataSource is the data source implementation.
+type policiesDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *policiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies"
+}
+
+// Schema defines the schema for the data source.
+func (d *policiesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	owner := func(description string, other string) tfschema.StringAttribute {
+		attr := schema.DataSourceOptionalString(description)
+		attr.Validators = []validator.String{stringvalidator.ExactlyOneOf(path.MatchRoot(other))}
+		return attr
+	}
+
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get all Policies in effect for an Application or Organization, including those inherited from parent Organizations.\n\n" +
+			"The action each Policy takes at each stage is read from an internal endpoint used by the IQ Server UI. It is not part of the supported IQ Server API and may change or be removed in any version of IQ Server.",
+		Attributes: map[string]tfschema.Attribute{
+			"id":              schema.DataSourceComputedString("The ID of this resource."),
+			"application_id":  owner("Internal ID of the Application. Exactly one of `application_id` or `organization_id` must be set.", "organization_id"),
+			"organization_id": owner("Internal ID of the Organization - use `ROOT_ORGANIZATION_ID` for the Root Organization. Exactly one of `application_id` or `organization_id` must be set.", "application_id"),
+			"policies": schema.DataSourceComputedListNestedAttribute(
+				"Policies in effect - those owned by the Application or Organization first, followed by those of each parent Organization in turn",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"id":           schema.DataSourceComputedString("Internal ID of the Policy"),
+						"name":         schema.DataSourceComputedString("Name of the Policy"),
+						"policy_type":  schema.DataSourceComputedString("Type of the Policy - for example `security` or `license`"),
+						"threat_level": schema.DataSourceComputedInt32("Threat level of the Policy (0 - 10)"),
+						"owner_id":     schema.DataSourceComputedString("Internal ID of the Organization or Application that owns the Policy"),
+						"owner_type":   schema.DataSourceComputedString("Type of the owner of the Policy - `ORGANIZATION` or `APPLICATION`"),
+						"inherited":    schema.DataSourceComputedBool("Whether the Policy is inherited - that is, owned by an Organization above the requested Application or Organization"),
+						"actions":      schema.DataSourceComputedStringMap("Action the Policy takes at each stage where it does more than record a violation - for example `build` => `warn`. Empty if the Policy only records violations"),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *policiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.PoliciesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	// Owners whose Policies are in effect, closest first
+	ownerIds := make([]string, 0)
+	organizationId := data.OrganizationId.ValueString()
+	if !data.ApplicationId.IsNull() {
+		app, httpResponse, err := d.Client.ApplicationsAPI.GetApplication(d.AuthContext(ctx), data.ApplicationId.ValueString()).Execute()
+		if err != nil || httpResponse.StatusCode != http.StatusOK {
+			errors.HandleAPIError(common.ERR_FAILED_READING_APPLICATION, &err, httpResponse, &resp.Diagnostics)
+			return
+		}
+		ownerIds = append(ownerIds, app.GetId())
+		organizationId = app.GetOrganizationId()
+	}
+
+	organizationIds, httpResponse, err := common.ReadOrganizationAncestry(ctx, d.Client, d.Auth, organizationId)
+	if err != nil {
+		errors.HandleAPIError(common.ERR_FAILED_READING_ORGANIZATION, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+	ownerIds = append(ownerIds, organizationIds...)
+
+	apiResponse, httpResponse, err := d.Client.PoliciesAPI.GetPolicies(d.AuthContext(ctx)).Execute()
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(common.ERR_FAILED_READING_POLICIES, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+
+	policiesByOwner := make(map[string][]sonatypeiq.ApiPolicyDTO)
+	for _, policy := range apiResponse.Policies {
+		policiesByOwner[policy.GetOwnerId()] = append(policiesByOwner[policy.GetOwnerId()], policy)
+	}
+
+	// Map api response to State
+	data.Policies = make([]model.PolicyModel, 0)
+	for i, ownerId := range ownerIds {
+		policies := policiesByOwner[ownerId]
+		if len(policies) == 0 {
+			continue
+		}
+		sort.Slice(policies, func(a, b int) bool { return policies[a].GetName() < policies[b].GetName() })
+
+		actions, httpResponse, err := common.ReadPolicyActions(ctx, d.Client, d.Auth, policies[0].GetOwnerType(), ownerId)
+		if err != nil {
+			if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf(common.ERR_FAILED_READING_POLICY_ACTIONS, ownerId),
+					fmt.Sprintf(common.ERR_INTERNAL_ENDPOINT_NOT_SUPPORTED, "Policy stage actions", fmt.Sprintf(common.REST_PATH_POLICIES, strings.ToLower(policies[0].GetOwnerType()), ownerId)),
+				)
+				return
+			}
+			errors.HandleAPIError(
+				fmt.Sprintf(common.ERR_FAILED_READING_POLICY_ACTIONS, ownerId),
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+			return
+		}
+
+		for _, policy := range policies {
+			p := model.PolicyModel{}
+			p.MapFromApi(ctx, &policy, i > 0, actions[policy.GetId()])
+			data.Policies = append(data.Policies, p)
+		}
+	}
+
+	if !data.ApplicationId.IsNull() {
+		data.ID = types.StringValue("policies-" + data.ApplicationId.ValueString())
+	} else {
+		data.ID = types.StringValue("policies-" + data.OrganizationId.ValueString())
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
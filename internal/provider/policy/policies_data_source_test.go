@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPoliciesDataSource(t *testing.T) {
+	appName := `TFACC` + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config:      utils_test.ProviderConfig + `data "sonatypeiq_policies" "none" {}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Read testing
+			{
+				Config: testAccPoliciesDataSource(appName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonatypeiq_policies.root", "id", fmt.Sprintf("policies-%s", common.ROOT_ORGANIZATION_ID)),
+					resource.TestCheckResourceAttrSet("data.sonatypeiq_policies.root", "policies.#"),
+					resource.TestCheckResourceAttrSet("data.sonatypeiq_policies.root", "policies.0.name"),
+					resource.TestCheckResourceAttr("data.sonatypeiq_policies.root", "policies.0.owner_id", common.ROOT_ORGANIZATION_ID),
+					resource.TestCheckResourceAttr("data.sonatypeiq_policies.root", "policies.0.inherited", "false"),
+					resource.TestCheckResourceAttrSet("data.sonatypeiq_policies.app", "policies.#"),
+					resource.TestCheckResourceAttr("data.sonatypeiq_policies.app", "policies.0.inherited", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonatypeiq_policies.app", "policies.*", map[string]string{
+						"owner_id":   common.ROOT_ORGANIZATION_ID,
+						"owner_type": "ORGANIZATION",
+						"inherited":  "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccPoliciesDataSource(name string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+data "sonatypeiq_organization" "sandbox" {
+  name = "Sandbox Organization"
+}
+
+resource "sonatypeiq_application" "test" {
+  name            = "%s"
+  public_id       = "%s"
+  organization_id = data.sonatypeiq_organization.sandbox.id
+}
+
+data "sonatypeiq_policies" "root" {
+  organization_id = "%s"
+}
+
+data "sonatypeiq_policies" "app" {
+  application_id = sonatypeiq_application.test.id
+}
+`, name, name, common.ROOT_ORGANIZATION_ID)
+}
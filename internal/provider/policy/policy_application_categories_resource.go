@@ -0,0 +1,304 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	sharedrschema "github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// policyApplicationCategoriesResource is the resource implementation.
+type policyApplicationCategoriesResource struct {
+	common.BaseResource
+}
+
+// NewPolicyApplicationCategoriesResource is a helper function to simplify the provider implementation.
+func NewPolicyApplicationCategoriesResource() resource.Resource {
+	return &policyApplicationCategoriesResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *policyApplicationCategoriesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_application_categories"
+}
+
+// Schema defines the schema for the resource.
+func (r *policyApplicationCategoriesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this resource to restrict a Policy to Applications in the given Application Categories. This resource is authoritative - any Application Categories applied to the Policy outside of Terraform will be removed.\n\n" +
+			"Application Categories are applied to Policies through internal endpoints used by the IQ Server UI. They are not part of the supported IQ Server API and may change or be removed in any version of IQ Server.",
+		Attributes: map[string]schema.Attribute{
+			"id": sharedrschema.ResourceComputedString("Internal ID for Terraform State"),
+			"policy_id": sharedrschema.ResourceRequiredStringWithPlanModifier(
+				"Internal ID of the Policy",
+				[]planmodifier.String{stringplanmodifier.RequiresReplace()},
+			),
+			"organization_id": sharedrschema.ResourceRequiredStringWithPlanModifier(
+				"Internal ID of the Organization that owns the Policy. Use `ROOT_ORGANIZATION_ID` for the Root Organization.",
+				[]planmodifier.String{stringplanmodifier.RequiresReplace()},
+			),
+			"category_ids": sharedrschema.ResourceRequiredStringSet("Internal IDs of the Application Categories the Policy is restricted to. An empty set applies the Policy to all Applications."),
+			"last_updated": sharedrschema.ResourceLastUpdated(),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *policyApplicationCategoriesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan model.PolicyApplicationCategoriesModelResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_PLAN, resp.Diagnostics.Errors()))
+		return
+	}
+
+	// Validate the Policy exists and is owned by the Organization
+	policy := r.doReadPolicy(ctx, plan.PolicyId.ValueString(), &resp.Diagnostics)
+	if policy == nil {
+		if !resp.Diagnostics.HasError() {
+			errors.AddValidationDiagnostic(&resp.Diagnostics, "policy_id", fmt.Sprintf(common.ERR_POLICY_DID_NOT_EXIST, plan.PolicyId.ValueString()))
+		}
+		return
+	}
+	if policy.GetOwnerId() != plan.OrganizationId.ValueString() {
+		errors.AddValidationDiagnostic(
+			&resp.Diagnostics,
+			"organization_id",
+			fmt.Sprintf("Policy %s is owned by %s %s", plan.PolicyId.ValueString(), strings.ToLower(policy.GetOwnerType()), policy.GetOwnerId()),
+		)
+		return
+	}
+
+	r.doUpsert(ctx, &plan, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update State
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *policyApplicationCategoriesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.PolicyApplicationCategoriesModelResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_STATE, resp.Diagnostics.Errors()))
+		return
+	}
+
+	policy := r.doReadPolicy(ctx, state.PolicyId.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if policy == nil {
+		resp.State.RemoveResource(ctx)
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf(common.ERR_POLICY_DID_NOT_EXIST, state.PolicyId.ValueString()),
+			"Removing Policy Application Categories from state",
+		)
+		return
+	}
+
+	apiResponse, ok := r.doRead(ctx, state.OrganizationId.ValueString(), &resp.State, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Update State based on Response
+	state.MapFromApi(ctx, apiResponse)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *policyApplicationCategoriesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan model.PolicyApplicationCategoriesModelResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_PLAN, resp.Diagnostics.Errors()))
+		return
+	}
+
+	r.doUpsert(ctx, &plan, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update State
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *policyApplicationCategoriesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state model.PolicyApplicationCategoriesModelResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_STATE, resp.Diagnostics.Errors()))
+		return
+	}
+
+	// Removing all Application Categories applies the Policy to all Applications again
+	httpResponse, err := common.ExecuteRestJsonRequest(
+		ctx,
+		r.Client,
+		r.Auth,
+		http.MethodPut,
+		fmt.Sprintf(common.REST_PATH_POLICY_TAGS, state.OrganizationId.ValueString(), state.PolicyId.ValueString()),
+		make([]sonatypeiq.PolicyTag, 0),
+		nil,
+	)
+
+	if err != nil {
+		errors.HandleAPIError(
+			"Error removing Application Categories from Policy",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+}
+
+// Import
+// Format: ORGANIZATION_ID,POLICY_ID
+func (r *policyApplicationCategoriesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <organization-id>,<policy-id> - Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_id"), idParts[1])...)
+}
+
+func (r *policyApplicationCategoriesResource) doRead(ctx context.Context, organizationId string, respState *tfsdk.State, respDiags *diag.Diagnostics) ([]sonatypeiq.PolicyTag, bool) {
+	apiResponse, httpResponse, err := r.Client.ApplicationCategoriesAPI.GetAppliedPolicyTags(r.AuthContext(ctx), organizationId).Execute()
+
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			respState.RemoveResource(ctx)
+			errors.HandleAPIWarning(
+				"Organization with ID did not exist to read Policy Application Categories",
+				&err,
+				httpResponse,
+				respDiags,
+			)
+		} else {
+			errors.HandleAPIError(
+				common.ERR_FAILED_READING_POLICY_APPLICATION_CATEGORIES,
+				&err,
+				httpResponse,
+				respDiags,
+			)
+		}
+		return nil, false
+	}
+
+	return apiResponse, true
+}
+
+func (r *policyApplicationCategoriesResource) doReadPolicy(ctx context.Context, policyId string, respDiags *diag.Diagnostics) *sonatypeiq.ApiPolicyDTO {
+	apiResponse, httpResponse, err := r.Client.PoliciesAPI.GetPolicies(r.AuthContext(ctx)).Execute()
+
+	if err != nil {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_POLICIES,
+			&err,
+			httpResponse,
+			respDiags,
+		)
+		return nil
+	}
+
+	for _, policy := range apiResponse.Policies {
+		if policy.GetId() == policyId {
+			return &policy
+		}
+	}
+
+	return nil
+}
+
+func (r *policyApplicationCategoriesResource) doUpsert(ctx context.Context, plan *model.PolicyApplicationCategoriesModelResource, respState *tfsdk.State, respDiags *diag.Diagnostics) {
+	httpResponse, err := common.ExecuteRestJsonRequest(
+		ctx,
+		r.Client,
+		r.Auth,
+		http.MethodPut,
+		fmt.Sprintf(common.REST_PATH_POLICY_TAGS, plan.OrganizationId.ValueString(), plan.PolicyId.ValueString()),
+		plan.MapToApi(ctx),
+		nil,
+	)
+
+	if err != nil {
+		errors.HandleAPIError(
+			"Error applying Application Categories to Policy",
+			&err,
+			httpResponse,
+			respDiags,
+		)
+		return
+	}
+
+	apiResponse, ok := r.doRead(ctx, plan.OrganizationId.ValueString(), respState, respDiags)
+	if !ok {
+		return
+	}
+
+	// Map response to State
+	plan.MapFromApi(ctx, apiResponse)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
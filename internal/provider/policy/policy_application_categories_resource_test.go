@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy_test
+
+import (
+	"fmt"
+	"os"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicyApplicationCategoriesResource(t *testing.T) {
+	// ID of a Policy owned by the Root Organization that can be safely scoped during the test
+	policyId := os.Getenv("IQ_TEST_ROOT_POLICY_ID")
+	if policyId == "" {
+		t.Skip("IQ_TEST_ROOT_POLICY_ID not set - skipping")
+	}
+
+	randomId := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceName := "sonatypeiq_policy_application_categories.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPolicyApplicationCategoriesResource(randomId, policyId, "sonatypeiq_application_category.cat1.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s,%s", common.ROOT_ORGANIZATION_ID, policyId)),
+					resource.TestCheckResourceAttr(resourceName, "policy_id", policyId),
+					resource.TestCheckResourceAttr(resourceName, "organization_id", common.ROOT_ORGANIZATION_ID),
+					resource.TestCheckResourceAttr(resourceName, "category_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// Update
+			{
+				Config: testAccPolicyApplicationCategoriesResource(randomId, policyId, "sonatypeiq_application_category.cat1.id, sonatypeiq_application_category.cat2.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_id", policyId),
+					resource.TestCheckResourceAttr(resourceName, "category_ids.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// Validate
+			{
+				Config:             testAccPolicyApplicationCategoriesResource(randomId, policyId, "sonatypeiq_application_category.cat1.id, sonatypeiq_application_category.cat2.id"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPolicyApplicationCategoriesResource(randomId, policyId, categoryIds string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatypeiq_application_category" "cat1" {
+  name            = "pci-%s"
+  description     = "PCI %s"
+  organization_id = "%s"
+  color           = "dark-red"
+}
+
+resource "sonatypeiq_application_category" "cat2" {
+  name            = "pci-extra-%s"
+  description     = "PCI Extra %s"
+  organization_id = "%s"
+  color           = "dark-blue"
+}
+
+resource "sonatypeiq_policy_application_categories" "test" {
+  policy_id       = "%s"
+  organization_id = "%s"
+  category_ids    = [%s]
+}`, randomId, randomId, common.ROOT_ORGANIZATION_ID, randomId, randomId, common.ROOT_ORGANIZATION_ID, policyId, common.ROOT_ORGANIZATION_ID, categoryIds)
+}
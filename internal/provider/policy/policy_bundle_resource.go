@@ -0,0 +1,262 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	sharedrschema "github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// policyBundleResource is the resource implementation.
+type policyBundleResource struct {
+	common.BaseResource
+}
+
+// NewPolicyBundleResource is a helper function to simplify the provider implementation.
+func NewPolicyBundleResource() resource.Resource {
+	return &policyBundleResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *policyBundleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_bundle"
+}
+
+// Schema defines the schema for the resource.
+func (r *policyBundleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Use this resource to import a complete set of Policies into an Organization.
+
+The Policy JSON is the export produced by the Sonatype IQ UI (Policies, Labels, License Threat Groups and Application Categories). Importing replaces all Policies defined on the Organization. Destroying this resource leaves the Policies in place.
+
+Policies are exported and imported through internal endpoints used by the IQ Server UI. They are not part of the supported IQ Server API and may change or be removed in any version of IQ Server.`,
+		Attributes: map[string]schema.Attribute{
+			"id": sharedrschema.ResourceComputedString("Internal ID for Terraform State"),
+			"organization_id": sharedrschema.ResourceRequiredStringWithPlanModifier(
+				"Internal ID of the Organization to import the Policies into. Use `ROOT_ORGANIZATION_ID` for the Root Organization.",
+				[]planmodifier.String{stringplanmodifier.RequiresReplace()},
+			),
+			"policy_json":  policyJsonAttribute(),
+			"last_updated": sharedrschema.ResourceLastUpdated(),
+		},
+	}
+}
+
+// policyJsonAttribute returns the schema for the Policy export JSON, compared by content rather than by text
+func policyJsonAttribute() schema.StringAttribute {
+	attr := sharedrschema.ResourceRequiredString("Policy export JSON - for example `file(\"policies.json\")`. Formatting, ordering and the IDs Sonatype IQ Server generates are ignored when comparing with the Policies on the server - references between elements are compared by name.")
+	attr.CustomType = common.PolicyBundleJsonType{}
+	return attr
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *policyBundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan model.PolicyBundleModelResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_PLAN, resp.Diagnostics.Errors()))
+		return
+	}
+
+	r.doImport(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update State
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *policyBundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.PolicyBundleModelResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_STATE, resp.Diagnostics.Errors()))
+		return
+	}
+
+	// Organization ID is not known after import
+	if state.OrganizationId.IsNull() {
+		state.OrganizationId = state.ID
+	}
+
+	respBody, httpResponse, err := common.ExecuteRestRequest(
+		ctx,
+		r.Client,
+		r.Auth,
+		http.MethodGet,
+		fmt.Sprintf(common.REST_PATH_POLICY_EXPORT, state.OrganizationId.ValueString()),
+		"",
+		nil,
+	)
+
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			errors.HandleAPIWarning(
+				"Organization with ID did not exist to export Policies",
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+		} else {
+			errors.HandleAPIError(
+				common.ERR_FAILED_READING_POLICY_BUNDLE,
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+		}
+		return
+	}
+
+	exported := common.NewPolicyBundleJsonValue(string(respBody))
+	if _, err := common.NormalizePolicyBundle(exported.ValueString()); err != nil {
+		resp.Diagnostics.AddError(common.ERR_FAILED_READING_POLICY_BUNDLE, fmt.Sprintf("Policy export was not valid JSON: %v", err))
+		return
+	}
+
+	// Only replace the configured JSON if the Policies on the server have drifted from it - formatting, ordering
+	// and server generated IDs are ignored
+	if state.PolicyJson.IsNull() {
+		state.PolicyJson = exported
+	} else if equal, _ := state.PolicyJson.StringSemanticEquals(ctx, exported); !equal {
+		tflog.Info(ctx, fmt.Sprintf("Policies for Organization %s differ from the configured Policy JSON", state.OrganizationId.ValueString()))
+		state.PolicyJson = exported
+	}
+
+	// Update State
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *policyBundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan model.PolicyBundleModelResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_PLAN, resp.Diagnostics.Errors()))
+		return
+	}
+
+	r.doImport(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update State
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *policyBundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state model.PolicyBundleModelResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_STATE, resp.Diagnostics.Errors()))
+		return
+	}
+
+	// There is no API to remove an imported set of Policies
+	resp.Diagnostics.AddWarning(
+		"Policies have not been removed",
+		fmt.Sprintf("The Policies imported into Organization %s remain in place and must be removed through the Sonatype IQ UI if no longer required.", state.OrganizationId.ValueString()),
+	)
+}
+
+// Import
+// Format: ORGANIZATION_ID
+func (r *policyBundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *policyBundleResource) doImport(ctx context.Context, plan *model.PolicyBundleModelResource, respDiags *diag.Diagnostics) {
+	if _, err := common.NormalizePolicyBundle(plan.PolicyJson.ValueString()); err != nil {
+		errors.AddValidationDiagnostic(respDiags, "policy_json", fmt.Sprintf("Policy JSON is not valid JSON: %v", err))
+		return
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "policy.json")
+	if err == nil {
+		_, err = part.Write([]byte(plan.PolicyJson.ValueString()))
+	}
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		respDiags.AddError("Error preparing Policy import", err.Error())
+		return
+	}
+
+	_, httpResponse, err := common.ExecuteRestRequest(
+		ctx,
+		r.Client,
+		r.Auth,
+		http.MethodPost,
+		fmt.Sprintf(common.REST_PATH_POLICY_IMPORT, plan.OrganizationId.ValueString()),
+		writer.FormDataContentType(),
+		body,
+	)
+
+	if err != nil {
+		errors.HandleAPIError(
+			"Error importing Policies",
+			&err,
+			httpResponse,
+			respDiags,
+		)
+		return
+	}
+
+	plan.ID = plan.OrganizationId
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy_test
+
+import (
+	"fmt"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccPolicyBundleJson = `{
+  "policies": [],
+  "labels": [],
+  "licenseThreatGroups": [],
+  "tags": []
+}`
+
+func TestAccPolicyBundleResource(t *testing.T) {
+	orgName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceName := "sonatypeiq_policy_bundle.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPolicyBundleResource(orgName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", common.ORGANIZATION_ID_REGEX),
+					resource.TestMatchResourceAttr(resourceName, "organization_id", common.ORGANIZATION_ID_REGEX),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_json"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// Validate
+			{
+				Config:             testAccPolicyBundleResource(orgName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Import - the exported JSON differs in text but not in content from the configured JSON
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "policy_json"},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+					imported, err := common.NormalizePolicyBundle(states[0].Attributes["policy_json"])
+					if err != nil {
+						return fmt.Errorf("imported policy_json is not valid JSON: %v", err)
+					}
+					configured, _ := common.NormalizePolicyBundle(testAccPolicyBundleJson)
+					if imported != configured {
+						return fmt.Errorf("imported policy_json %s does not match configured %s", imported, configured)
+					}
+					return nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPolicyBundleResource(orgName string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatypeiq_organization" "org" {
+  name                   = "%s"
+  parent_organization_id = "`+common.ROOT_ORGANIZATION_ID+`"
+}
+
+resource "sonatypeiq_policy_bundle" "test" {
+  organization_id = sonatypeiq_organization.org.id
+  policy_json     = <<-EOT
+%s
+EOT
+}`, orgName, testAccPolicyBundleJson)
+}
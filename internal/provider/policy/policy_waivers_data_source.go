@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &policyWaiversDataSource{}
+	_ datasource.DataSourceWithConfigure        = &policyWaiversDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &policyWaiversDataSource{}
+)
+
+// PolicyWaiversDataSource is a helper function to simplify the provider implementation.
+func PolicyWaiversDataSource() datasource.DataSource {
+	return &policyWaiversDataSource{}
+}
+
+// policyWaiversDataSource is the data source implementation.
+type policyWaiversDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *policyWaiversDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_waivers"
+}
+
+// Schema defines the schema for the data source.
+func (d *policyWaiversDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: `Use this data source to list the Policy Waivers for an Application or Organization.
+
+Waivers are ordered by expiry, soonest first - waivers that never expire are listed last.`,
+		Attributes: map[string]tfschema.Attribute{
+			"id": schema.DataSourceComputedString("The ID of this resource."),
+			"owner_type": schema.DataSourceRequiredStringEnum(
+				"Type of the owner - `application` or `organization`",
+				common.OWNER_TYPE_APPLICATION,
+				common.OWNER_TYPE_ORGANIZATION,
+			),
+			"owner_id":             schema.DataSourceRequiredString("Internal ID of the Application or Organization. Use `ROOT_ORGANIZATION_ID` for the Root Organization."),
+			"include_descendants":  schema.DataSourceOptionalBool("Also list waivers for all Organizations and Applications beneath an Organization - defaults to `false`. Only valid when `owner_type` is `organization`"),
+			"expiring_within_days": schema.DataSourceOptionalInt32WithRange("Only list waivers that expire within this many days - waivers that have already expired are included", 0, math.MaxInt32),
+			"waivers": schema.DataSourceComputedListNestedAttribute(
+				"Policy Waivers",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"id":                                schema.DataSourceComputedString("Internal ID of the Policy Waiver"),
+						"policy_id":                         schema.DataSourceComputedString("Internal ID of the waived Policy"),
+						"policy_name":                       schema.DataSourceComputedString("Name of the waived Policy"),
+						"threat_level":                      schema.DataSourceComputedInt32("Threat level of the waived Policy"),
+						"scope_owner_type":                  schema.DataSourceComputedString("Type of the owner the waiver applies to"),
+						"scope_owner_id":                    schema.DataSourceComputedString("Internal ID of the owner the waiver applies to"),
+						"scope_owner_name":                  schema.DataSourceComputedString("Name of the owner the waiver applies to"),
+						"comment":                           schema.DataSourceComputedString("Comment recorded with the waiver"),
+						"reason":                            schema.DataSourceComputedString("Reason given for the waiver"),
+						"creator_id":                        schema.DataSourceComputedString("User ID of the creator of the waiver"),
+						"creator_name":                      schema.DataSourceComputedString("Name of the creator of the waiver"),
+						"create_time":                       schema.DataSourceComputedString("When the waiver was created (RFC3339)"),
+						"expiry_time":                       schema.DataSourceComputedString("When the waiver expires (RFC3339) - null if it never expires"),
+						"expire_when_remediation_available": schema.DataSourceComputedBool("Whether the waiver expires when a remediation becomes available"),
+						"component_name":                    schema.DataSourceComputedString("Display name of the matched component"),
+						"package_url":                       schema.DataSourceComputedString("Package URL of the matched component"),
+						"hash":                              schema.DataSourceComputedString("Hash of the matched component"),
+						"matcher_strategy":                  schema.DataSourceComputedString("How the waiver matches components - for example `EXACT_COMPONENT` or `ALL_VERSIONS`"),
+						"vulnerability_id":                  schema.DataSourceComputedString("Vulnerability the waiver is limited to, if any"),
+					},
+				},
+			),
+		},
+	}
+}
+
+func (d *policyWaiversDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		includeDescendantsValidator{},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *policyWaiversDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.PolicyWaiversModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	var expiresBefore *time.Time
+	if !data.ExpiringWithinDays.IsNull() {
+		t := time.Now().AddDate(0, 0, int(data.ExpiringWithinDays.ValueInt32()))
+		expiresBefore = &t
+	}
+
+	// Waivers for an Organization include those for every Organization and Application beneath it
+	apiResponse, httpResponse, err := d.Client.PolicyWaiversAPI.GetPolicyWaivers(d.AuthContext(ctx), data.OwnerType.ValueString(), data.OwnerId.ValueString()).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(common.ERR_FAILED_READING_POLICY_WAIVERS, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+
+	scopeOwnerId := ""
+	if data.OwnerType.ValueString() == common.OWNER_TYPE_ORGANIZATION && !data.IncludeDescendants.ValueBool() {
+		scopeOwnerId = data.OwnerId.ValueString()
+	}
+	waivers := filterPolicyWaivers(apiResponse, scopeOwnerId, expiresBefore)
+
+	// Map api response to State
+	data.MapFromApi(waivers)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// filterPolicyWaivers returns the waivers scoped to scopeOwnerId (any scope if empty) that expire before
+// expiresBefore (if set), ordered by expiry - soonest first, with waivers that never expire last
+func filterPolicyWaivers(waivers []sonatypeiq.ApiPolicyWaiverDTO, scopeOwnerId string, expiresBefore *time.Time) []sonatypeiq.ApiPolicyWaiverDTO {
+	filtered := make([]sonatypeiq.ApiPolicyWaiverDTO, 0)
+	for _, waiver := range waivers {
+		if scopeOwnerId != "" && waiver.GetScopeOwnerId() != scopeOwnerId {
+			continue
+		}
+		if expiresBefore != nil && (waiver.ExpiryTime == nil || waiver.ExpiryTime.After(*expiresBefore)) {
+			continue
+		}
+		filtered = append(filtered, waiver)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		a, b := filtered[i].ExpiryTime, filtered[j].ExpiryTime
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+	return filtered
+}
+
+// includeDescendantsValidator rejects include_descendants for owners that cannot have descendants
+type includeDescendantsValidator struct{}
+
+func (v includeDescendantsValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("include_descendants may only be true when owner_type is %q", common.OWNER_TYPE_ORGANIZATION)
+}
+
+func (v includeDescendantsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v includeDescendantsValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var ownerType types.String
+	var includeDescendants types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("owner_type"), &ownerType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("include_descendants"), &includeDescendants)...)
+	if resp.Diagnostics.HasError() || ownerType.IsUnknown() || ownerType.IsNull() {
+		return
+	}
+
+	if includeDescendants.ValueBool() && ownerType.ValueString() != common.OWNER_TYPE_ORGANIZATION {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("include_descendants"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("`include_descendants` may only be true when `owner_type` is %q", common.OWNER_TYPE_ORGANIZATION),
+		)
+	}
+}
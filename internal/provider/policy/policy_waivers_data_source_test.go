@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/policy"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccPolicyWaiversDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: utils_test.ProviderConfig + `data "sonatypeiq_policy_waivers" "app" {
+					owner_type          = "application"
+					owner_id            = "any"
+					include_descendants = true
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Read testing
+			{
+				Config: utils_test.ProviderConfig + fmt.Sprintf(`data "sonatypeiq_policy_waivers" "root" {
+					owner_type = "organization"
+					owner_id   = "%s"
+				}
+
+				data "sonatypeiq_policy_waivers" "all_expiring" {
+					owner_type           = "organization"
+					owner_id             = "%s"
+					include_descendants  = true
+					expiring_within_days = 30
+				}`, common.ROOT_ORGANIZATION_ID, common.ROOT_ORGANIZATION_ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonatypeiq_policy_waivers.root", "id", fmt.Sprintf("policy-waivers-organization-%s", common.ROOT_ORGANIZATION_ID)),
+					resource.TestCheckResourceAttrSet("data.sonatypeiq_policy_waivers.root", "waivers.#"),
+					resource.TestCheckResourceAttr("data.sonatypeiq_policy_waivers.all_expiring", "include_descendants", "true"),
+					resource.TestCheckResourceAttr("data.sonatypeiq_policy_waivers.all_expiring", "expiring_within_days", "30"),
+					resource.TestCheckResourceAttrSet("data.sonatypeiq_policy_waivers.all_expiring", "waivers.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterPolicyWaivers(t *testing.T) {
+	now := time.Now()
+	waiver := func(id, scopeOwnerId string, expiresIn *time.Duration) sonatypeiq.ApiPolicyWaiverDTO {
+		w := sonatypeiq.ApiPolicyWaiverDTO{
+			PolicyWaiverId: sonatypeiq.PtrString(id),
+			ScopeOwnerId:   sonatypeiq.PtrString(scopeOwnerId),
+		}
+		if expiresIn != nil {
+			w.SetExpiryTime(now.Add(*expiresIn))
+		}
+		return w
+	}
+	days := func(n int) *time.Duration {
+		d := time.Duration(n) * 24 * time.Hour
+		return &d
+	}
+	ids := func(waivers []sonatypeiq.ApiPolicyWaiverDTO) []string {
+		result := make([]string, 0)
+		for _, w := range waivers {
+			result = append(result, w.GetPolicyWaiverId())
+		}
+		return result
+	}
+
+	waivers := []sonatypeiq.ApiPolicyWaiverDTO{
+		waiver("never", "org", nil),
+		waiver("in-60-days", "org", days(60)),
+		waiver("expired", "app", days(-2)),
+		waiver("in-10-days", "app", days(10)),
+		waiver("also-never", "app", nil),
+		waiver("in-5-days", "org", days(5)),
+	}
+
+	// Ordered by expiry, soonest first, then waivers that never expire in their original order
+	assert.Equal(t, []string{"expired", "in-5-days", "in-10-days", "in-60-days", "never", "also-never"}, ids(policy.FilterPolicyWaivers(waivers, "", nil)))
+
+	// Only waivers scoped to the owner
+	assert.Equal(t, []string{"in-5-days", "in-60-days", "never"}, ids(policy.FilterPolicyWaivers(waivers, "org", nil)))
+
+	// Only waivers expiring within 30 days - including those that have already expired
+	expiresBefore := now.AddDate(0, 0, 30)
+	assert.Equal(t, []string{"expired", "in-5-days", "in-10-days"}, ids(policy.FilterPolicyWaivers(waivers, "", &expiresBefore)))
+	assert.Equal(t, []string{"in-5-days"}, ids(policy.FilterPolicyWaivers(waivers, "org", &expiresBefore)))
+
+	assert.Empty(t, policy.FilterPolicyWaivers(nil, "", nil))
+}
@@ -24,7 +24,9 @@ import (
 	"strings"
 	"terraform-provider-sonatypeiq/internal/provider/application"
 	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/component"
 	"terraform-provider-sonatypeiq/internal/provider/organization"
+	"terraform-provider-sonatypeiq/internal/provider/policy"
 	"terraform-provider-sonatypeiq/internal/provider/role"
 	"terraform-provider-sonatypeiq/internal/provider/scm"
 	"terraform-provider-sonatypeiq/internal/provider/system"
@@ -199,16 +201,22 @@ func (p *SonatypeIqProvider) Resources(ctx context.Context) []func() resource.Re
 	return []func() resource.Resource{
 		application.NewApplicationResource,
 		application.NewApplicationRoleMembershipResource,
+		application.NewApplicationSbomResource,
 		organization.NewApplicationCategoryResource,
 		organization.NewOrganizationResource,
 		organization.NewOrganizationRoleMembershipResource,
+		policy.NewPolicyApplicationCategoriesResource,
+		policy.NewPolicyBundleResource,
 		role.NewRoleResource,
 		scm.NewSourceControlResource,
 		system.NewConfigCrowdResource,
+		system.NewConfigFeaturesResource,
+		system.NewConfigJiraResource,
 		system.NewConfigMailResource,
 		system.NewConfigProductLicenseResource,
 		system.NewConfigProxyServerResource,
 		system.NewConfigSamlResource,
+		system.NewConfigUserTokenResource,
 		system.NewSystemConfigResource,
 		user.NewUserResource,
 		user.NewUserTokenResource,
@@ -220,11 +228,33 @@ func (p *SonatypeIqProvider) DataSources(ctx context.Context) []func() datasourc
 		application.ApplicationDataSource,
 		application.ApplicationsDataSource,
 		application.ApplicationCategoriesDataSource,
+		application.ApplicationPolicyViolationsDataSource,
+		application.ApplicationReportsDataSource,
+		application.ApplicationSbomExportDataSource,
+		component.ComponentDataSource,
+		component.VulnerabilityDataSource,
 		organization.OrganizationDataSource,
+		organization.OrganizationTreeDataSource,
 		organization.OrganizationsDataSource,
+		policy.LicenseThreatGroupsDataSource,
+		policy.LicensesDataSource,
+		policy.PoliciesDataSource,
+		policy.PolicyWaiversDataSource,
+		role.EffectivePermissionsDataSource,
 		role.RoleDataSource,
+		role.RoleMembershipsDataSource,
+		role.RolesDataSource,
+		scm.SourceControlDataSource,
+		system.AuditLogDataSource,
+		system.ConfigCrowdDataSource,
+		system.ConfigLicenseDataSource,
+		system.ConfigMailDataSource,
+		system.ConfigProxyServerDataSource,
 		system.ConfigSamlDataSource,
+		system.ServerDataSource,
 		system.SystemConfigDataSource,
+		user.UserDataSource,
+		user.UsersDataSource,
 	}
 }
 
@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package role
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &effectivePermissionsDataSource{}
+	_ datasource.DataSourceWithConfigure        = &effectivePermissionsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &effectivePermissionsDataSource{}
+)
+
+// EffectivePermissionsDataSource is a helper function to simplify the provider implementation.
+func EffectivePermissionsDataSource() datasource.DataSource {
+	return &effectivePermissionsDataSource{}
+}
+
+// effectivePermissionsDataSource is the data source implementation.
+type effectivePermissionsDataSource struct {
+	common.BaseDataSource
+}
+
+type roleOwner struct {
+	ownerType string
+	ownerId   string
+}
+
+// Metadata returns the data source type name.
+func (d *effectivePermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_permissions"
+}
+
+// Schema defines the schema for the data source.
+func (d *effectivePermissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: `Use this data source to get the effective Permissions of a user or group for an Application, Organization or globally.
+
+Permissions are resolved from the Roles the user or group is granted directly (by name) on the owner, on each Organization above it up to the Root Organization, and globally. Membership of groups is not resolved for a user - query each group separately.
+
+Permissions are returned as the IDs used by the IQ Server Roles API, which differ from the names shown in the IQ Server UI - for example ` + "`WRITE`" + ` for Edit IQ Elements, ` + "`READ`" + ` for View IQ Elements and ` + "`WAIVE_POLICY_VIOLATIONS`" + ` for Waive Policy Violations.
+
+Requires Sonatype IQ Server 198 or later.`,
+		Attributes: map[string]tfschema.Attribute{
+			"id": schema.DataSourceComputedString("Internal ID for Terraform State"),
+			"owner_type": schema.DataSourceRequiredStringEnum(
+				"Type of the owner - `application`, `organization` or `global`",
+				common.OWNER_TYPE_APPLICATION,
+				common.OWNER_TYPE_GLOBAL,
+				common.OWNER_TYPE_ORGANIZATION,
+			),
+			"owner_id":    schema.DataSourceOptionalString("Internal ID of the Application or Organization - required unless `owner_type` is `global`. Use `ROOT_ORGANIZATION_ID` for the Root Organization."),
+			"user_name":   schema.DataSourceOptionalString("The username of the user (mutually exclusive with group_name)"),
+			"group_name":  schema.DataSourceOptionalString("The group name of the group (mutually exclusive with user_name)"),
+			"permissions": schema.DataSourceComputedStringSet("IDs of the Permissions the user or group has, as used by the IQ Server Roles API - for example `WRITE` (Edit IQ Elements) or `WAIVE_POLICY_VIOLATIONS`"),
+			"roles": schema.DataSourceComputedListNestedAttribute(
+				"Roles granted to the user or group that the Permissions come from",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"role_id":    schema.DataSourceComputedString("Internal ID of the Role"),
+						"role_name":  schema.DataSourceComputedString("Name of the Role"),
+						"owner_type": schema.DataSourceComputedString("Type of the owner the Role is granted on"),
+						"owner_id":   schema.DataSourceComputedString("Internal ID of the owner the Role is granted on"),
+					},
+				},
+			),
+		},
+	}
+}
+
+func (d *effectivePermissionsDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("user_name"),
+			path.MatchRoot("group_name"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *effectivePermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.EffectivePermissionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	if data.OwnerType.ValueString() != common.OWNER_TYPE_GLOBAL && data.OwnerId.IsNull() {
+		errors.AddValidationDiagnostic(&resp.Diagnostics, "owner_id", fmt.Sprintf("`owner_id` must be set when `owner_type` is `%s`", data.OwnerType.ValueString()))
+		return
+	}
+
+	if d.IqVersion < 198 {
+		resp.Diagnostics.AddError(
+			common.ERR_FAILED_READING_EFFECTIVE_PERMISSIONS,
+			fmt.Sprintf("Sonatype IQ Server version %d does not report Role Permissions - version 198 or later is required", d.IqVersion),
+		)
+		return
+	}
+
+	memberType, memberName := common.MEMBER_TYPE_USER, data.UserName.ValueString()
+	if !data.GroupName.IsNull() {
+		memberType, memberName = common.MEMBER_TYPE_GROUP, data.GroupName.ValueString()
+	}
+
+	// Role memberships granted globally, on the owner and on each Organization above it
+	mappings := make([]sonatypeiq.ApiRoleMemberMappingDTO, 0)
+	globalResponse, httpResponse, err := d.Client.RoleMembershipsAPI.GetRoleMembershipsGlobalOrRepositoryContainer(d.AuthContext(ctx), common.OWNER_TYPE_GLOBAL).Execute()
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(common.ERR_FAILED_READING_ROLE_MEMBERSHIPS, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+	mappings = append(mappings, globalResponse.MemberMappings...)
+
+	if data.OwnerType.ValueString() != common.OWNER_TYPE_GLOBAL {
+		owners, ok := d.ownerAncestry(ctx, data.OwnerType.ValueString(), data.OwnerId.ValueString(), &resp.Diagnostics)
+		if !ok {
+			return
+		}
+
+		for _, owner := range owners {
+			ownerResponse, httpResponse, err := d.Client.RoleMembershipsAPI.GetRoleMembershipsApplicationOrOrganization(
+				d.AuthContext(ctx),
+				owner.ownerType,
+				owner.ownerId,
+			).Execute()
+			if err != nil || httpResponse.StatusCode != http.StatusOK {
+				errors.HandleAPIError(common.ERR_FAILED_READING_ROLE_MEMBERSHIPS, &err, httpResponse, &resp.Diagnostics)
+				return
+			}
+			mappings = append(mappings, ownerResponse.MemberMappings...)
+		}
+	}
+
+	rolesResponse, httpResponse, err := d.Client.RolesAPI.GetRoles(d.AuthContext(ctx)).Execute()
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(common.ERR_FAILED_READING_ROLES, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+	roles := make(map[string]*sonatypeiq.ApiRoleDTO)
+	for i := range rolesResponse.Roles {
+		roles[rolesResponse.Roles[i].GetId()] = &rolesResponse.Roles[i]
+	}
+
+	// Find the Roles granted to the user or group
+	grants := make([]model.EffectiveRoleGrantModel, 0)
+	seenGrants := make(map[string]bool)
+	for _, mapping := range mappings {
+		for _, member := range mapping.Members {
+			if strings.ToLower(member.GetType()) != memberType || !strings.EqualFold(member.GetUserOrGroupName(), memberName) {
+				continue
+			}
+			grantKey := strings.Join([]string{mapping.GetRoleId(), strings.ToLower(member.GetOwnerType()), member.GetOwnerId()}, "/")
+			if seenGrants[grantKey] {
+				continue
+			}
+			seenGrants[grantKey] = true
+
+			grant := model.EffectiveRoleGrantModel{
+				RoleId:    types.StringPointerValue(mapping.RoleId),
+				RoleName:  types.StringNull(),
+				OwnerType: types.StringValue(strings.ToLower(member.GetOwnerType())),
+				OwnerId:   types.StringPointerValue(member.OwnerId),
+			}
+			if role, ok := roles[mapping.GetRoleId()]; ok {
+				grant.RoleName = types.StringPointerValue(role.Name)
+			}
+			grants = append(grants, grant)
+		}
+	}
+	sort.SliceStable(grants, func(i, j int) bool { return grants[i].RoleName.ValueString() < grants[j].RoleName.ValueString() })
+
+	// Resolve the Permissions of each Role granted
+	permissions := make(map[string]bool)
+	resolvedRoles := make(map[string]bool)
+	for _, grant := range grants {
+		roleId := grant.RoleId.ValueString()
+		role, ok := roles[roleId]
+		if !ok || resolvedRoles[roleId] {
+			continue
+		}
+		resolvedRoles[roleId] = true
+
+		roleDetail := readRoleDetail(ctx, &d.BaseDataSource, role, &resp.Diagnostics)
+		if roleDetail == nil {
+			return
+		}
+		for _, category := range roleDetail.PermissionCategories {
+			for _, permission := range category.Permissions {
+				if permission.GetAllowed() {
+					permissions[permission.GetId()] = true
+				}
+			}
+		}
+	}
+
+	permissionIds := make([]string, 0, len(permissions))
+	for permissionId := range permissions {
+		permissionIds = append(permissionIds, permissionId)
+	}
+	sort.Strings(permissionIds)
+
+	// Map api response to State
+	data.MapFromApi(ctx, permissionIds, grants)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ownerAncestry returns the owner type and ID of an Application or Organization followed by those of each
+// Organization above it, ending with the Root Organization
+func (d *effectivePermissionsDataSource) ownerAncestry(ctx context.Context, ownerType, ownerId string, respDiags *diag.Diagnostics) ([]roleOwner, bool) {
+	owners := make([]roleOwner, 0)
+	organizationId := ownerId
+	if ownerType == common.OWNER_TYPE_APPLICATION {
+		app, httpResponse, err := d.Client.ApplicationsAPI.GetApplication(d.AuthContext(ctx), ownerId).Execute()
+		if err != nil || httpResponse.StatusCode != http.StatusOK {
+			errors.HandleAPIError(common.ERR_FAILED_READING_APPLICATION, &err, httpResponse, respDiags)
+			return nil, false
+		}
+		owners = append(owners, roleOwner{ownerType: common.OWNER_TYPE_APPLICATION, ownerId: ownerId})
+		organizationId = app.GetOrganizationId()
+	}
+
+	organizationIds, httpResponse, err := common.ReadOrganizationAncestry(ctx, d.Client, d.Auth, organizationId)
+	if err != nil {
+		errors.HandleAPIError(common.ERR_FAILED_READING_ORGANIZATION, &err, httpResponse, respDiags)
+		return nil, false
+	}
+	for _, id := range organizationIds {
+		owners = append(owners, roleOwner{ownerType: common.OWNER_TYPE_ORGANIZATION, ownerId: id})
+	}
+	return owners, true
+}
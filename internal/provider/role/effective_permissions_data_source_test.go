@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package role_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEffectivePermissionsDataSource(t *testing.T) {
+	var resourceName = "data.sonatypeiq_effective_permissions.app"
+	userName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: utils_test.ProviderConfig + `data "sonatypeiq_effective_permissions" "none" {
+  owner_type = "global"
+}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Read testing - Role granted on the Organization applies to the Application
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+data "sonatypeiq_organization" "sandbox" {
+  name = "Sandbox Organization"
+}
+
+data "sonatypeiq_application" "sandbox" {
+  public_id = "sandbox-application"
+}
+
+data "sonatypeiq_role" "developer" {
+  name = "Developer"
+}
+
+resource "sonatypeiq_user" "user" {
+  username   = "%s"
+  password   = "randomthing"
+  first_name = "Example"
+  last_name  = "User"
+  email      = "example@user.tld"
+}
+
+resource "sonatypeiq_organization_role_membership" "test" {
+  role_id         = data.sonatypeiq_role.developer.id
+  organization_id = data.sonatypeiq_organization.sandbox.id
+  user_name       = sonatypeiq_user.user.username
+}
+
+data "sonatypeiq_effective_permissions" "app" {
+  owner_type = "application"
+  owner_id   = data.sonatypeiq_application.sandbox.id
+  user_name  = sonatypeiq_user.user.username
+  depends_on = [sonatypeiq_organization_role_membership.test]
+}
+
+data "sonatypeiq_effective_permissions" "global" {
+  owner_type = "global"
+  user_name  = sonatypeiq_user.user.username
+  depends_on = [sonatypeiq_organization_role_membership.test]
+}`, userName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", common.ROLE_ID_VIEW_IQ_ELEMENTS),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "roles.*", map[string]string{
+						"role_name":  "Developer",
+						"owner_type": "organization",
+					}),
+					resource.TestCheckResourceAttr("data.sonatypeiq_effective_permissions.global", "permissions.#", "0"),
+					resource.TestCheckResourceAttr("data.sonatypeiq_effective_permissions.global", "roles.#", "0"),
+				),
+			},
+			// Read testing - Role granted on a parent Organization applies to a child Organization
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+data "sonatypeiq_role" "developer" {
+  name = "Developer"
+}
+
+resource "sonatypeiq_user" "user" {
+  username   = "%s"
+  password   = "randomthing"
+  first_name = "Example"
+  last_name  = "User"
+  email      = "example@user.tld"
+}
+
+resource "sonatypeiq_organization" "parent" {
+  name                   = "%s-parent"
+  parent_organization_id = "%s"
+}
+
+resource "sonatypeiq_organization" "child" {
+  name                   = "%s-child"
+  parent_organization_id = sonatypeiq_organization.parent.id
+}
+
+resource "sonatypeiq_organization_role_membership" "parent" {
+  role_id         = data.sonatypeiq_role.developer.id
+  organization_id = sonatypeiq_organization.parent.id
+  user_name       = sonatypeiq_user.user.username
+}
+
+data "sonatypeiq_effective_permissions" "child" {
+  owner_type = "organization"
+  owner_id   = sonatypeiq_organization.child.id
+  user_name  = sonatypeiq_user.user.username
+  depends_on = [sonatypeiq_organization_role_membership.parent]
+}`, userName, userName, common.ROOT_ORGANIZATION_ID, userName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.sonatypeiq_effective_permissions.child", "permissions.*", common.ROLE_ID_VIEW_IQ_ELEMENTS),
+					resource.TestCheckResourceAttr("data.sonatypeiq_effective_permissions.child", "roles.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sonatypeiq_effective_permissions.child", "roles.0.owner_id", "sonatypeiq_organization.parent", "id"),
+					resource.TestCheckResourceAttr("data.sonatypeiq_effective_permissions.child", "roles.0.role_name", "Developer"),
+					resource.TestCheckResourceAttr("data.sonatypeiq_effective_permissions.child", "roles.0.owner_type", common.OWNER_TYPE_ORGANIZATION),
+				),
+			},
+		},
+	})
+}
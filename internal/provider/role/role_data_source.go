@@ -25,7 +25,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
 	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
 	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
 )
@@ -60,47 +62,52 @@ func (d *roleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 			"name":        schema.DataSourceRequiredString("The role name"),
 			"description": schema.DataSourceComputedString("Role Description"),
 			"built_in":    schema.DataSourceComputedBool("Whether this is a built-in Role in Sonatype IQ"),
-			"permissions": schema.DataSourceComputedSingleNestedAttribute(
-				`Permissions for this Role.
+			"permissions": rolePermissionsDataSourceAttribute(),
+		},
+	}
+}
+
+// rolePermissionsDataSourceAttribute returns the schema for the Permissions of a Role
+func rolePermissionsDataSourceAttribute() tfschema.SingleNestedAttribute {
+	return schema.DataSourceComputedSingleNestedAttribute(
+		`Permissions for this Role.
 				
 **NOTE:** Requires Sonatype IQ Server 198 or later.`,
+		map[string]tfschema.Attribute{
+			"admin": schema.DataSourceComputedSingleNestedAttribute(
+				"Administrator Permmissions",
+				map[string]tfschema.Attribute{
+					"access_audit_log": schema.DataSourceComputedBool("Access to Audit Logs"),
+					"view_roles":       schema.DataSourceComputedBool("View all Roles"),
+				},
+			),
+			"iq": schema.DataSourceComputedSingleNestedAttribute(
+				"Sonatype IQ Permmissions",
+				map[string]tfschema.Attribute{
+					"add_applications":                      schema.DataSourceComputedBool("Can add Applications"),
+					"claim_components":                      schema.DataSourceComputedBool("Can Claim Components"),
+					"edit_access_control":                   schema.DataSourceComputedBool("Can edit Access Control"),
+					"edit_iq_elements":                      schema.DataSourceComputedBool("Can edit IQ Elements"),
+					"edit_proprietary_components":           schema.DataSourceComputedBool("Can edit Proprietary Components"),
+					"evaluate_applications":                 schema.DataSourceComputedBool("Can Evaluate Applications"),
+					"evaluate_individual_components":        schema.DataSourceComputedBool("Can Evaluate Individual Components"),
+					"manage_automatic_application_creation": schema.DataSourceComputedBool("Can manage Automatic Application creation"),
+					"manage_automatic_scm_configuration":    schema.DataSourceComputedBool("Can manage Automatic SCM Configuration"),
+					"view_iq_elements":                      schema.DataSourceComputedBool("Can view IQ Elements"),
+				},
+			),
+			"remediation": schema.DataSourceComputedSingleNestedAttribute(
+				"Remediation Permmissions",
 				map[string]tfschema.Attribute{
-					"admin": schema.DataSourceComputedSingleNestedAttribute(
-						"Administrator Permmissions",
-						map[string]tfschema.Attribute{
-							"access_audit_log": schema.DataSourceComputedBool("Access to Audit Logs"),
-							"view_roles":       schema.DataSourceComputedBool("View all Roles"),
-						},
-					),
-					"iq": schema.DataSourceComputedSingleNestedAttribute(
-						"Sonatype IQ Permmissions",
-						map[string]tfschema.Attribute{
-							"add_applications":                      schema.DataSourceComputedBool("Can add Applications"),
-							"claim_components":                      schema.DataSourceComputedBool("Can Claim Components"),
-							"edit_access_control":                   schema.DataSourceComputedBool("Can edit Access Control"),
-							"edit_iq_elements":                      schema.DataSourceComputedBool("Can edit IQ Elements"),
-							"edit_proprietary_components":           schema.DataSourceComputedBool("Can edit Proprietary Components"),
-							"evaluate_applications":                 schema.DataSourceComputedBool("Can Evaluate Applications"),
-							"evaluate_individual_components":        schema.DataSourceComputedBool("Can Evaluate Individual Components"),
-							"manage_automatic_application_creation": schema.DataSourceComputedBool("Can manage Automatic Application creation"),
-							"manage_automatic_scm_configuration":    schema.DataSourceComputedBool("Can manage Automatic SCM Configuration"),
-							"view_iq_elements":                      schema.DataSourceComputedBool("Can view IQ Elements"),
-						},
-					),
-					"remediation": schema.DataSourceComputedSingleNestedAttribute(
-						"Remediation Permmissions",
-						map[string]tfschema.Attribute{
-							"change_licenses":                 schema.DataSourceComputedBool("Can change Licenses"),
-							"change_security_vulnerabilities": schema.DataSourceComputedBool("Can change Security Vulnerabilities"),
-							"create_pull_requests":            schema.DataSourceComputedBool("Can create Pull Requests"),
-							"review_legal_obligations":        schema.DataSourceComputedBool("Can review Legal Obligations"),
-							"waive_policy_violations":         schema.DataSourceComputedBool("Can Waive Policy Violations"),
-						},
-					),
+					"change_licenses":                 schema.DataSourceComputedBool("Can change Licenses"),
+					"change_security_vulnerabilities": schema.DataSourceComputedBool("Can change Security Vulnerabilities"),
+					"create_pull_requests":            schema.DataSourceComputedBool("Can create Pull Requests"),
+					"review_legal_obligations":        schema.DataSourceComputedBool("Can review Legal Obligations"),
+					"waive_policy_violations":         schema.DataSourceComputedBool("Can Waive Policy Violations"),
 				},
 			),
 		},
-	}
+	)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -130,25 +137,11 @@ func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	for _, apiRole := range apiResponse.Roles {
 		if *apiRole.Name == data.Name.ValueString() {
-			if d.IqVersion >= 198 {
-				apiRoleResponse, httpResponse, err := d.Client.RolesAPI.GetRoleById(d.AuthContext(ctx), *apiRole.Id).Execute()
-
-				if err != nil {
-					errors.HandleAPIError(
-						common.ERR_FAILED_READING_ROLE_BY_ID,
-						&err,
-						httpResponse,
-						&resp.Diagnostics,
-					)
-					return
-				} else if httpResponse.StatusCode != http.StatusOK {
-					errors.AddAPIErrorDiagnostic(&resp.Diagnostics, "read", "Role", httpResponse, err)
-					return
-				}
-				data.MapFromApi(apiRoleResponse)
-			} else {
-				data.MapFromApi(&apiRole)
+			apiRoleResponse := readRoleDetail(ctx, &d.BaseDataSource, &apiRole, &resp.Diagnostics)
+			if apiRoleResponse == nil {
+				return
 			}
+			data.MapFromApi(apiRoleResponse)
 			break
 		}
 	}
@@ -166,3 +159,27 @@ func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 }
+
+// readRoleDetail returns the Role including its Permissions where the Sonatype IQ Server version supports it
+func readRoleDetail(ctx context.Context, d *common.BaseDataSource, apiRole *sonatypeiq.ApiRoleDTO, respDiags *diag.Diagnostics) *sonatypeiq.ApiRoleDTO {
+	if d.IqVersion < 198 {
+		return apiRole
+	}
+
+	apiRoleResponse, httpResponse, err := d.Client.RolesAPI.GetRoleById(d.AuthContext(ctx), *apiRole.Id).Execute()
+
+	if err != nil {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_ROLE_BY_ID,
+			&err,
+			httpResponse,
+			respDiags,
+		)
+		return nil
+	} else if httpResponse.StatusCode != http.StatusOK {
+		errors.AddAPIErrorDiagnostic(respDiags, "read", "Role", httpResponse, err)
+		return nil
+	}
+
+	return apiRoleResponse
+}
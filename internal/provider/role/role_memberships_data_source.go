@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package role
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &roleMembershipsDataSource{}
+	_ datasource.DataSourceWithConfigure = &roleMembershipsDataSource{}
+)
+
+// RoleMembershipsDataSource is a helper function to simplify the provider implementation.
+func RoleMembershipsDataSource() datasource.DataSource {
+	return &roleMembershipsDataSource{}
+}
+
+// roleMembershipsDataSource is the data source implementation.
+type roleMembershipsDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *roleMembershipsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_memberships"
+}
+
+// Schema defines the schema for the data source.
+func (d *roleMembershipsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get all Role memberships for an Application or Organization, including those inherited from parent Organizations",
+		Attributes: map[string]tfschema.Attribute{
+			"id": schema.DataSourceComputedString("Internal ID for Terraform State"),
+			"owner_type": schema.DataSourceRequiredStringEnum(
+				"Type of the owner - `application` or `organization`",
+				common.OWNER_TYPE_APPLICATION,
+				common.OWNER_TYPE_ORGANIZATION,
+			),
+			"owner_id": schema.DataSourceRequiredString("Internal ID of the Application or Organization. Use `ROOT_ORGANIZATION_ID` for the Root Organization."),
+			"memberships": schema.DataSourceComputedListNestedAttribute(
+				"List of Roles and their members",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"role_id":   schema.DataSourceComputedString("Internal ID of the Role"),
+						"role_name": schema.DataSourceComputedString("Name of the Role"),
+						"members": schema.DataSourceComputedListNestedAttribute(
+							"Users and Groups granted the Role",
+							tfschema.NestedAttributeObject{
+								Attributes: map[string]tfschema.Attribute{
+									"type":       schema.DataSourceComputedString("Type of member - `user` or `group`"),
+									"name":       schema.DataSourceComputedString("User or Group name"),
+									"owner_type": schema.DataSourceComputedString("Type of the owner the membership is defined on"),
+									"owner_id":   schema.DataSourceComputedString("Internal ID of the owner the membership is defined on"),
+									"inherited":  schema.DataSourceComputedBool("Whether the membership is inherited from a parent Organization"),
+								},
+							},
+						),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *roleMembershipsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.RoleMembershipsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	apiResponse, httpResponse, err := d.Client.RoleMembershipsAPI.GetRoleMembershipsApplicationOrOrganization(
+		d.AuthContext(ctx),
+		data.OwnerType.ValueString(),
+		data.OwnerId.ValueString(),
+	).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_ROLE_MEMBERSHIPS,
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	// Name each Role
+	rolesResponse, httpResponse, err := d.Client.RolesAPI.GetRoles(d.AuthContext(ctx)).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_ROLES,
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	roleNames := make(map[string]string)
+	for _, apiRole := range rolesResponse.Roles {
+		roleNames[apiRole.GetId()] = apiRole.GetName()
+	}
+
+	// Map api response to State
+	data.MapFromApi(apiResponse, roleNames)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package role
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &rolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &rolesDataSource{}
+)
+
+// RolesDataSource is a helper function to simplify the provider implementation.
+func RolesDataSource() datasource.DataSource {
+	return &rolesDataSource{}
+}
+
+// rolesDataSource is the data source implementation.
+type rolesDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+// Schema defines the schema for the data source.
+func (d *rolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get all built-in and custom Roles",
+		Attributes: map[string]tfschema.Attribute{
+			"id": schema.DataSourceComputedString("Internal ID for Terraform State"),
+			"roles": schema.DataSourceComputedListNestedAttribute(
+				"List of Roles",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"id":          schema.DataSourceComputedString("Internal ID of this Role"),
+						"name":        schema.DataSourceComputedString("The role name"),
+						"description": schema.DataSourceComputedString("Role Description"),
+						"built_in":    schema.DataSourceComputedBool("Whether this is a built-in Role in Sonatype IQ"),
+						"permissions": rolePermissionsDataSourceAttribute(),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.RolesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	apiResponse, httpResponse, err := d.Client.RolesAPI.GetRoles(d.AuthContext(ctx)).Execute()
+
+	if err != nil {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_ROLES,
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	} else if httpResponse.StatusCode != http.StatusOK {
+		errors.AddAPIErrorDiagnostic(&resp.Diagnostics, "read", "Roles", httpResponse, err)
+		return
+	}
+
+	data.ID = types.StringValue("all-roles")
+	data.Roles = make([]model.RoleModel, 0)
+	for _, apiRole := range apiResponse.Roles {
+		apiRoleResponse := readRoleDetail(ctx, &d.BaseDataSource, &apiRole, &resp.Diagnostics)
+		if apiRoleResponse == nil {
+			return
+		}
+		role := model.RoleModel{}
+		role.MapFromApi(apiRoleResponse)
+		data.Roles = append(data.Roles, role)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package role_test
+
+import (
+	testutil "terraform-provider-sonatypeiq/internal/provider/testutil"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRolesDataSource(t *testing.T) {
+	var resourceName = "data.sonatypeiq_roles.roles"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			// Permissions not available prior to NXIQ 198
+			testutil.SkipIfNxiqVersionOlderThan(t, 198)
+		},
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: utils_test.ProviderConfig + `data "sonatypeiq_roles" "roles" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "all-roles"),
+					resource.TestCheckResourceAttrSet(resourceName, "roles.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "roles.*", map[string]string{
+						"id":                                 "1da70fae1fd54d6cb7999871ebdb9a36",
+						"name":                               "Developer",
+						"built_in":                           "true",
+						"permissions.iq.view_iq_elements":    "true",
+						"permissions.iq.edit_access_control": "false",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &sourceControlDataSource{}
+	_ datasource.DataSourceWithConfigure = &sourceControlDataSource{}
+)
+
+// SourceControlDataSource is a helper function to simplify the provider implementation.
+func SourceControlDataSource() datasource.DataSource {
+	return &sourceControlDataSource{}
+}
+
+// sourceControlDataSource is the data source implementation.
+type sourceControlDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *sourceControlDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_control"
+}
+
+// Schema defines the schema for the data source.
+func (d *sourceControlDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	setting := func(description string, value tfschema.Attribute) tfschema.SingleNestedAttribute {
+		return schema.DataSourceComputedSingleNestedAttribute(
+			description,
+			map[string]tfschema.Attribute{
+				"value":          value,
+				"inherited":      schema.DataSourceComputedBool("Whether the value is inherited from a parent Organization"),
+				"inherited_from": schema.DataSourceComputedString("Name of the parent Organization the value is inherited from"),
+			},
+		)
+	}
+
+	resp.Schema = tfschema.Schema{
+		Description: `Use this data source to get the effective Source Control configuration for an Application or Organization.
+
+Each setting includes the value configured directly on the owner, or the value inherited from a parent Organization.`,
+		Attributes: map[string]tfschema.Attribute{
+			"id":       schema.DataSourceComputedString("Internal ID for Terraform State"),
+			"owner_id": schema.DataSourceRequiredString("Must be a valid organization or application ID, for the root organization use `ROOT_ORGANIZATION_ID`"),
+			"owner_type": schema.DataSourceRequiredStringEnum(
+				"The type of the owner, must be one of 'organization' or 'application'.",
+				common.OWNER_TYPE_APPLICATION,
+				common.OWNER_TYPE_ORGANIZATION,
+			),
+			"repository_url":                    schema.DataSourceComputedString("The SCM provider URL for the repository, only set for `owner_type` of `application`"),
+			"scm_provider":                      setting("The type of SCM Provider", schema.DataSourceComputedString("The type of SCM Provider")),
+			"base_branch":                       setting("The default branch", schema.DataSourceComputedString("The default branch")),
+			"user_name":                         setting("The user name used with the SCM Provider", schema.DataSourceComputedString("The user name used with the SCM Provider")),
+			"remediation_pull_requests_enabled": setting("Whether the Automated Pull Requests feature is enabled", schema.DataSourceComputedBool("Whether the Automated Pull Requests feature is enabled")),
+			"pull_request_commenting_enabled":   setting("Whether the Pull Request Commenting feature is enabled", schema.DataSourceComputedBool("Whether the Pull Request Commenting feature is enabled")),
+			"source_control_evaluation_enabled": setting("Whether Sonatype Lifecycle triggered source control evaluations are enabled", schema.DataSourceComputedBool("Whether Sonatype Lifecycle triggered source control evaluations are enabled")),
+			"commit_status_enabled":             setting("Whether commit status updates are enabled", schema.DataSourceComputedBool("Whether commit status updates are enabled")),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *sourceControlDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.SourceControlModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	apiResponse, httpResponse, err := d.Client.CompositeSourceControlAPI.GetCompositeSourceControlByOwner(
+		d.AuthContext(ctx),
+		data.OwnerType.ValueString(),
+		data.OwnerID.ValueString(),
+	).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_SCM_CONFIGURATION,
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	// Map api response to State
+	data.MapFromApi(apiResponse)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scm_test
+
+import (
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSourceControlDataSource(t *testing.T) {
+	rand := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	dataSourceName := "data.sonatypeiq_source_control.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceControlApplicationResource(rand, "true") + `
+data "sonatypeiq_source_control" "test" {
+  owner_type = "application"
+  owner_id   = sonatypeiq_source_control.test.owner_id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "repository_url", "https://github.com/sonatype-nexus-community/terraform-provider-sonatypeiq.git"),
+					// Configured on the Application
+					resource.TestCheckResourceAttr(dataSourceName, "base_branch.value", "my-cool-branch"),
+					resource.TestCheckResourceAttr(dataSourceName, "base_branch.inherited", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_request_commenting_enabled.value", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "pull_request_commenting_enabled.inherited", "false"),
+					// Inherited from the Root Organization
+					resource.TestCheckResourceAttr(dataSourceName, "scm_provider.value", "github"),
+					resource.TestCheckResourceAttr(dataSourceName, "scm_provider.inherited", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "scm_provider.inherited_from"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceControlDataSourceOrganization(t *testing.T) {
+	dataSourceName := "data.sonatypeiq_source_control.sandbox"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: utils_test.ProviderConfig + `
+data "sonatypeiq_organization" "sandbox" {
+  name = "Sandbox Organization"
+}
+
+data "sonatypeiq_source_control" "sandbox" {
+  owner_type = "organization"
+  owner_id   = data.sonatypeiq_organization.sandbox.id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "repository_url"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &auditLogDataSource{}
+	_ datasource.DataSourceWithConfigure = &auditLogDataSource{}
+)
+
+// AuditLogDataSource is a helper function to simplify the provider implementation.
+func AuditLogDataSource() datasource.DataSource {
+	return &auditLogDataSource{}
+}
+
+// auditLogDataSource is the data source implementation.
+type auditLogDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *auditLogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_log"
+}
+
+// Schema defines the schema for the data source.
+func (d *auditLogDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	utcDate := func(description string) tfschema.StringAttribute {
+		attr := schema.DataSourceRequiredString(description)
+		attr.Validators = []validator.String{
+			stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in the format YYYY-MM-DD"),
+		}
+		return attr
+	}
+
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get the Sonatype IQ Server Audit Log for a date range. Requires the Access Audit Log permission.",
+		Attributes: map[string]tfschema.Attribute{
+			"id":         schema.DataSourceComputedString("The ID of this resource."),
+			"start_date": utcDate("First UTC date (YYYY-MM-DD) to return Audit Log entries for"),
+			"end_date":   utcDate("Last UTC date (YYYY-MM-DD) to return Audit Log entries for"),
+			"entries": schema.DataSourceComputedListNestedAttribute(
+				"Audit Log entries, oldest first",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"timestamp": schema.DataSourceComputedString("When the event occurred"),
+						"actor":     schema.DataSourceComputedString("User (or system) that initiated the event"),
+						"action":    schema.DataSourceComputedString("Type of event - for example `create`"),
+						"domain":    schema.DataSourceComputedString("Area of Sonatype IQ Server the event relates to - for example `governance.application`"),
+						"object":    schema.DataSourceComputedString("Object the event relates to"),
+						"raw":       schema.DataSourceComputedString("Audit Log entry as returned by Sonatype IQ Server"),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *auditLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.AuditLogModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	httpResponse, err := d.Client.AuditLogsAPI.GetAuditLogs(d.AuthContext(ctx)).
+		StartUtcDate(data.StartDate.ValueString()).
+		EndUtcDate(data.EndDate.ValueString()).
+		Execute()
+
+	if err != nil {
+		errors.HandleAPIError(common.ERR_FAILED_READING_AUDIT_LOG, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+
+	content, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(common.ERR_FAILED_READING_AUDIT_LOG, err.Error())
+		return
+	}
+
+	entries, err := common.ParseAuditLog(content)
+	if err != nil {
+		resp.Diagnostics.AddError(common.ERR_FAILED_READING_AUDIT_LOG, err.Error())
+		return
+	}
+
+	// Map api response to State
+	data.MapFromApi(entries)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package system_test
+
+import (
+	"fmt"
+	"regexp"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuditLogDataSource(t *testing.T) {
+	dataSourceName := "data.sonatypeiq_audit_log.log"
+	today := time.Now().UTC().Format("2006-01-02")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid date
+			{
+				Config: utils_test.ProviderConfig + `data "sonatypeiq_audit_log" "log" {
+					start_date = "yesterday"
+					end_date   = "today"
+				}`,
+				ExpectError: regexp.MustCompile("must be a date in the format YYYY-MM-DD"),
+			},
+			// Read testing
+			{
+				Config: utils_test.ProviderConfig + fmt.Sprintf(`data "sonatypeiq_audit_log" "log" {
+					start_date = "%s"
+					end_date   = "%s"
+				}`, today, today),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", fmt.Sprintf("%s,%s", today, today)),
+					resource.TestCheckResourceAttr(dataSourceName, "start_date", today),
+					resource.TestCheckResourceAttr(dataSourceName, "end_date", today),
+					resource.TestCheckResourceAttrSet(dataSourceName, "entries.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	sharedrschema "github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// configFeaturesResource is the resource implementation.
+type configFeaturesResource struct {
+	common.BaseResource
+}
+
+// NewConfigFeaturesResource is a helper function to simplify the provider implementation.
+func NewConfigFeaturesResource() resource.Resource {
+	return &configFeaturesResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *configFeaturesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_features"
+}
+
+// Schema defines the schema for the resource.
+func (r *configFeaturesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Enable or disable IQ Server features.
+
+Only the features listed are managed - removing a feature from the map, or destroying this resource, leaves the feature in its current state. Features the connected IQ Server does not know about are rejected at plan time.`,
+		Attributes: map[string]schema.Attribute{
+			"id":           sharedrschema.ResourceComputedString("Internal ID for Terraform State"),
+			"features":     sharedrschema.ResourceRequiredBoolMap("Map of IQ Server feature name to whether the feature is enabled"),
+			"last_updated": sharedrschema.ResourceLastUpdated(),
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (r *configFeaturesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() || r.Client == nil {
+		return
+	}
+
+	var plan model.ConfigFeaturesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Features.IsUnknown() {
+		return
+	}
+
+	// Validate against the features known to the connected server, where it can tell us
+	serverFeatures, _, err := r.doReadFeatures(ctx)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Unable to list features to validate against: %v", err))
+		resp.Diagnostics.AddWarning(
+			"Unable to validate IQ Server features",
+			fmt.Sprintf("Sonatype IQ Server version %d did not list its features, so feature names were not validated at plan time. Unknown features will be rejected by the server at apply.", r.IqVersion),
+		)
+		return
+	}
+
+	for feature := range plan.FeaturesAsMap(ctx) {
+		if _, ok := serverFeatures[feature]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("features").AtMapKey(feature),
+				"Unknown IQ Server feature",
+				fmt.Sprintf("Feature %q is not available on Sonatype IQ Server version %d.", feature, r.IqVersion),
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *configFeaturesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan model.ConfigFeaturesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_PLAN, resp.Diagnostics.Errors()))
+		return
+	}
+
+	r.doUpsert(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update State
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *configFeaturesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state model.ConfigFeaturesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_STATE, resp.Diagnostics.Errors()))
+		return
+	}
+
+	serverFeatures, httpResponse, err := r.doReadFeatures(ctx)
+	if err != nil {
+		if httpResponse != nil && (httpResponse.StatusCode == http.StatusNotFound || httpResponse.StatusCode == http.StatusMethodNotAllowed) {
+			// Older servers cannot list features - keep what we last applied
+			tflog.Debug(ctx, fmt.Sprintf("Sonatype IQ Server cannot list features: %v", err))
+			return
+		}
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_FEATURES,
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	// Update State based on Response
+	state.MapFromApi(ctx, serverFeatures)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *configFeaturesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan model.ConfigFeaturesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_PLAN, resp.Diagnostics.Errors()))
+		return
+	}
+
+	r.doUpsert(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update State
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *configFeaturesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Features are left in their current state
+}
+
+func (r *configFeaturesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// doReadFeatures returns the features known to the server and whether each is enabled
+func (r *configFeaturesResource) doReadFeatures(ctx context.Context) (map[string]bool, *http.Response, error) {
+	serverFeatures := make(map[string]bool)
+	httpResponse, err := common.ExecuteRestJsonRequest(ctx, r.Client, r.Auth, http.MethodGet, common.REST_PATH_FEATURES, nil, &serverFeatures)
+	return serverFeatures, httpResponse, err
+}
+
+func (r *configFeaturesResource) doUpsert(ctx context.Context, plan *model.ConfigFeaturesModel, respDiags *diag.Diagnostics) {
+	for feature, enabled := range plan.FeaturesAsMap(ctx) {
+		var httpResponse *http.Response
+		var err error
+		if enabled {
+			httpResponse, err = r.Client.FeatureConfigurationAPI.EnabledFeature(r.AuthContext(ctx), feature).Execute()
+		} else {
+			httpResponse, err = r.Client.FeatureConfigurationAPI.DisableFeature(r.AuthContext(ctx), feature).Execute()
+		}
+
+		if err != nil {
+			message := fmt.Sprintf("Error setting feature %q to %t", feature, enabled)
+			if httpResponse != nil && httpResponse.StatusCode == http.StatusBadRequest {
+				message = fmt.Sprintf("Feature %q is not known to the connected Sonatype IQ Server", feature)
+			}
+			errors.HandleAPIError(
+				message,
+				&err,
+				httpResponse,
+				respDiags,
+			)
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(common.STATE_ID_FEATURE_CONFIGURATION)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package system_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigFeaturesResource(t *testing.T) {
+	// Name of a feature that can be safely toggled on the test server
+	feature := os.Getenv("IQ_TEST_FEATURE")
+	if feature == "" {
+		t.Skip("IQ_TEST_FEATURE not set - skipping")
+	}
+
+	resourceName := "sonatypeiq_config_features.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Features unknown to the server are rejected at plan time
+			{
+				Config:      testAccConfigFeaturesResource("tf-acc-not-a-feature", true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unknown IQ Server feature"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccConfigFeaturesResource(feature, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", common.STATE_ID_FEATURE_CONFIGURATION),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("features.%s", feature), "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			{
+				Config: testAccConfigFeaturesResource(feature, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", common.STATE_ID_FEATURE_CONFIGURATION),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("features.%s", feature), "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// Validate
+			{
+				Config:             testAccConfigFeaturesResource(feature, false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccConfigFeaturesResource(feature string, enabled bool) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatypeiq_config_features" "test" {
+  features = {
+    "%s" = %t
+  }
+}`, feature, enabled)
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	sharedrschema "github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// configJiraResource is the resource implementation.
+type configJiraResource struct {
+	common.BaseResource
+}
+
+// NewConfigJiraResource is a helper function to simplify the provider implementation.
+func NewConfigJiraResource() resource.Resource {
+	return &configJiraResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *configJiraResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_jira"
+}
+
+// Schema defines the schema for the resource.
+func (r *configJiraResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage Jira integration configuration for IQ Server. Requires Terraform 1.11 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id":       sharedrschema.ResourceComputedString("Internal ID for Terraform State"),
+			"url":      sharedrschema.ResourceRequiredString("URL of the Jira server"),
+			"username": sharedrschema.ResourceRequiredString("Username to authenticate with the Jira server"),
+			"api_token": func() schema.StringAttribute {
+				attr := sharedrschema.ResourceSensitiveRequiredString("API Token (or password) to authenticate with the Jira server. This is never stored in Terraform State.")
+				attr.WriteOnly = true
+				return attr
+			}(),
+			"api_token_version": sharedrschema.ResourceOptionalInt32("Change this value to send an updated `api_token` to Sonatype IQ Server"),
+			"last_updated":      sharedrschema.ResourceLastUpdated(),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *configJiraResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan model.ConfigJiraModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var apiToken types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_token"), &apiToken)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_PLAN, resp.Diagnostics.Errors()))
+		return
+	}
+
+	r.doUpsert(ctx, &plan, apiToken, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update State
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *configJiraResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state model.ConfigJiraModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_STATE, resp.Diagnostics.Errors()))
+		return
+	}
+
+	apiResponse := r.doRead(ctx, &resp.State, &resp.Diagnostics)
+	if apiResponse == nil {
+		return
+	}
+
+	// Update State based on Response
+	state.MapFromApi(apiResponse)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *configJiraResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan model.ConfigJiraModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var apiToken types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_token"), &apiToken)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf(common.ERR_TF_GETTING_PLAN, resp.Diagnostics.Errors()))
+		return
+	}
+
+	r.doUpsert(ctx, &plan, apiToken, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update State
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *configJiraResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	httpResponse, err := r.Client.ConfigJiraAPI.DeleteConfiguration2(r.AuthContext(ctx)).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusNoContent {
+		resp.Diagnostics.AddError(
+			common.ERR_JIRA_CONFIGURATION_DID_NOT_EXIST,
+			fmt.Sprintf("%v", err),
+		)
+		return
+	}
+}
+
+func (r *configJiraResource) doRead(ctx context.Context, respState *tfsdk.State, respDiags *diag.Diagnostics) *sonatypeiq.ApiJiraConfigurationDTO {
+	apiResponse, httpResponse, err := r.Client.ConfigJiraAPI.GetConfiguration2(r.AuthContext(ctx)).Execute()
+
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			respState.RemoveResource(ctx)
+			errors.HandleAPIWarning(
+				common.ERR_JIRA_CONFIGURATION_DID_NOT_EXIST,
+				&err,
+				httpResponse,
+				respDiags,
+			)
+		} else {
+			errors.HandleAPIError(
+				common.ERR_FAILED_READING_JIRA_CONFIGURATION,
+				&err,
+				httpResponse,
+				respDiags,
+			)
+		}
+		return nil
+	}
+
+	return apiResponse
+}
+
+func (r *configJiraResource) doUpsert(ctx context.Context, model *model.ConfigJiraModel, apiToken types.String, respState *tfsdk.State, respDiags *diag.Diagnostics) {
+	httpResponse, err := r.Client.ConfigJiraAPI.SetConfiguration2(r.AuthContext(ctx)).ApiJiraConfigurationDTO(*model.MapToApi(apiToken)).Execute()
+
+	if err != nil {
+		errors.HandleAPIError(
+			"Error creating/updating Jira configuration",
+			&err,
+			httpResponse,
+			respDiags,
+		)
+		return
+	} else if httpResponse.StatusCode != http.StatusNoContent {
+		errors.HandleAPIError(
+			"Upsertion of Jira configuration was not successful",
+			&err,
+			httpResponse,
+			respDiags,
+		)
+		return
+	}
+
+	apiResponse := r.doRead(ctx, respState, respDiags)
+	if apiResponse == nil {
+		return
+	}
+
+	// Map response to State
+	model.MapFromApi(apiResponse)
+	model.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
+
+func (r *configJiraResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
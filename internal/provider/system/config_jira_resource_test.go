@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package system_test
+
+import (
+	"fmt"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigJiraResource(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceName := "sonatypeiq_config_jira.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccConfigJiraResource(randomStr, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify Jira configuration
+					resource.TestCheckResourceAttr(resourceName, "id", common.STATE_ID_JIRA_CONFIGURATION),
+					resource.TestCheckResourceAttr(resourceName, "url", fmt.Sprintf("https://jira.%s.tld", randomStr)),
+					resource.TestCheckResourceAttr(resourceName, "username", randomStr),
+					resource.TestCheckNoResourceAttr(resourceName, "api_token"),
+					resource.TestCheckResourceAttr(resourceName, "api_token_version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// Rotate API Token
+			{
+				Config: testAccConfigJiraResource(randomStr, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", common.STATE_ID_JIRA_CONFIGURATION),
+					resource.TestCheckNoResourceAttr(resourceName, "api_token"),
+					resource.TestCheckResourceAttr(resourceName, "api_token_version", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// Validate
+			{
+				Config:             testAccConfigJiraResource(randomStr, 2),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_token_version", "last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccConfigJiraResource(randomStr string, tokenVersion int) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatypeiq_config_jira" "test" {
+  url               = "https://jira.%s.tld"
+  username          = "%s"
+  api_token         = "fake-token-%d"
+  api_token_version = %d
+}`, randomStr, randomStr, tokenVersion, tokenVersion)
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &configLicenseDataSource{}
+	_ datasource.DataSourceWithConfigure = &configLicenseDataSource{}
+)
+
+// ConfigLicenseDataSource is a helper function to simplify the provider implementation.
+func ConfigLicenseDataSource() datasource.DataSource {
+	return &configLicenseDataSource{}
+}
+
+// configLicenseDataSource is the data source implementation.
+type configLicenseDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *configLicenseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_product_license"
+}
+
+// Schema defines the schema for the data source.
+func (d *configLicenseDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get details of the Product License installed in Sonatype IQ Server. The license data itself is never returned.\n\n" +
+			"`expiry_date`, `licensed_users` and `active_users` are read from `/rest/product/license`, an internal endpoint used by the IQ Server UI. " +
+			"It is not part of the supported IQ Server API and may change or be removed in any version of IQ Server. " +
+			"Where it is not available these attributes are null; where its response is not recognised, reading this data source fails.",
+		Attributes: map[string]tfschema.Attribute{
+			"id":                schema.DataSourceComputedString("The ID of this resource."),
+			"licensed_products": schema.DataSourceComputedStringList("Products (solutions) enabled by the installed license - for example `firewall` or `lifecycle`"),
+			"expiry_date":       schema.DataSourceComputedString("Date and time (RFC3339) the installed license expires"),
+			"licensed_users":    schema.DataSourceComputedInt32("Number of user seats the installed license allows, if the license is seat based"),
+			"active_users":      schema.DataSourceComputedInt32("Number of user seats currently in use, if reported by Sonatype IQ Server"),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *configLicenseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.ProductLicenseModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	solutions, httpResponse, err := d.Client.SolutionsAPI.GetLicensedSolutions(d.AuthContext(ctx)).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_PRODUCT_LICENSE,
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	details, httpResponse, err := common.ReadProductLicense(d.AuthContext(ctx), d.Client, d.Auth)
+
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			// Not all versions of Sonatype IQ Server report license details
+			errors.HandleAPIWarning(
+				"Product License details are not available - expiry and seat usage will be null",
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+			details = &common.ProductLicenseDetails{}
+		} else {
+			errors.HandleAPIError(
+				common.ERR_FAILED_READING_PRODUCT_LICENSE,
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+			return
+		}
+	}
+
+	// Map api response to State
+	data.MapFromApi(ctx, solutions, details)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &configMailDataSource{}
+	_ datasource.DataSourceWithConfigure = &configMailDataSource{}
+)
+
+// ConfigMailDataSource is a helper function to simplify the provider implementation.
+func ConfigMailDataSource() datasource.DataSource {
+	return &configMailDataSource{}
+}
+
+// configMailDataSource is the data source implementation.
+type configMailDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *configMailDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_mail"
+}
+
+// Schema defines the schema for the data source.
+func (d *configMailDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get the outbound email server configuration for Sonatype IQ Server. The password is never returned. All attributes other than `id` are null if no email server is configured.",
+		Attributes: map[string]tfschema.Attribute{
+			"id":                schema.DataSourceComputedString("The ID of this resource."),
+			"hostname":          schema.DataSourceComputedString("Hostname of the SMTP server"),
+			"port":              schema.DataSourceComputedInt32("Port Number for the SMTP server"),
+			"username":          schema.DataSourceComputedString("Username for the SMTP server"),
+			"ssl_enabled":       schema.DataSourceComputedBool("Whether SSL is enabled to SMTP server"),
+			"start_tls_enabled": schema.DataSourceComputedBool("Whether STARTTLS is enabled to SMTP server"),
+			"system_email":      schema.DataSourceComputedString("The email address emails sent by Sonatype IQ Server will appear FROM"),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *configMailDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.ConfigMailDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	apiResponse, httpResponse, err := d.Client.ConfigMailAPI.GetConfiguration3(d.AuthContext(ctx)).Execute()
+
+	if err != nil && (httpResponse == nil || httpResponse.StatusCode != http.StatusNotFound) {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_MAIL_CONFIGURATION,
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	// Map api response to State
+	if err == nil {
+		data.MapFromApi(apiResponse)
+	} else {
+		tflog.Info(ctx, common.ERR_MAIL_CONFIGURATION_DID_NOT_EXIST)
+		data.ID = types.StringValue(common.STATE_ID_MAIL_CONFIGURATION)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
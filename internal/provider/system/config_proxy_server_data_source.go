@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &configProxyServerDataSource{}
+	_ datasource.DataSourceWithConfigure = &configProxyServerDataSource{}
+)
+
+// ConfigProxyServerDataSource is a helper function to simplify the provider implementation.
+func ConfigProxyServerDataSource() datasource.DataSource {
+	return &configProxyServerDataSource{}
+}
+
+// configProxyServerDataSource is the data source implementation.
+type configProxyServerDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *configProxyServerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_proxy_server"
+}
+
+// Schema defines the schema for the data source.
+func (d *configProxyServerDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get the outbound Proxy Server configuration for Sonatype IQ Server. The password is never returned. All attributes other than `id` are null if no Proxy Server is configured.",
+		Attributes: map[string]tfschema.Attribute{
+			"id":            schema.DataSourceComputedString("The ID of this resource."),
+			"hostname":      schema.DataSourceComputedString("Hostname of the Proxy Server"),
+			"port":          schema.DataSourceComputedInt32("Port Number for the Proxy Server"),
+			"username":      schema.DataSourceComputedString("Username for the Proxy Server"),
+			"exclude_hosts": schema.DataSourceComputedStringSet("Hosts excluded from communication via the Proxy Server"),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *configProxyServerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.ConfigProxyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	apiResponse, httpResponse, err := d.Client.ConfigProxyServerAPI.GetConfiguration4(d.AuthContext(ctx)).Execute()
+
+	if err != nil && (httpResponse == nil || httpResponse.StatusCode != http.StatusNotFound) {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_PROXY_CONFIGURATION,
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	// Map api response to State
+	if err == nil {
+		data.MapFromApi(ctx, apiResponse)
+	} else {
+		tflog.Info(ctx, common.ERR_PROXY_CONFIGURATION_DID_NOT_EXIST)
+		data.ID = types.StringValue(common.STATE_ID_PROXY_CONFIGURATION)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package system_test
+
+import (
+	"fmt"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigProxyServerDataSource(t *testing.T) {
+	randomStr := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	dataSourceName := "data.sonatypeiq_config_proxy_server.proxy"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccConfigProxyFullResource(randomStr) + `
+data "sonatypeiq_config_proxy_server" "proxy" {
+  depends_on = [sonatypeiq_config_proxy_server.test]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", common.STATE_ID_PROXY_CONFIGURATION),
+					resource.TestCheckResourceAttr(dataSourceName, "hostname", fmt.Sprintf("smtp.%s.tld", randomStr)),
+					resource.TestCheckResourceAttr(dataSourceName, "port", "465"),
+					resource.TestCheckResourceAttr(dataSourceName, "username", randomStr),
+					resource.TestCheckNoResourceAttr(dataSourceName, "password"),
+					resource.TestCheckResourceAttr(dataSourceName, "exclude_hosts.#", "2"),
+				),
+			},
+		},
+	})
+}
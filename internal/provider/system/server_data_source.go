@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serverDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverDataSource{}
+)
+
+// ServerDataSource is a helper function to simplify the provider implementation.
+func ServerDataSource() datasource.DataSource {
+	return &serverDataSource{}
+}
+
+// serverDataSource is the data source implementation.
+type serverDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *serverDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+// Schema defines the schema for the data source.
+func (d *serverDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get information about the Sonatype IQ Server this provider is connected to. Version numbers are 0 if the server did not report its version.",
+		Attributes: map[string]tfschema.Attribute{
+			"id":                schema.DataSourceComputedString("The ID of this resource."),
+			"base_url":          schema.DataSourceComputedString("URL of Sonatype IQ Server as configured for this provider"),
+			"version":           schema.DataSourceComputedString("Full version of Sonatype IQ Server - for example `1.201.0-02`"),
+			"version_major":     schema.DataSourceComputedInt32("Major version number of Sonatype IQ Server - for example `1`"),
+			"version_minor":     schema.DataSourceComputedInt32("Minor version number of Sonatype IQ Server - for example `201`. This is the number Sonatype commonly refer to as the release."),
+			"version_patch":     schema.DataSourceComputedInt32("Patch version number of Sonatype IQ Server - for example `0`"),
+			"version_build":     schema.DataSourceComputedInt32("Build number of Sonatype IQ Server - for example `2`"),
+			"licensed_products": schema.DataSourceComputedStringList("Products (solutions) enabled by the installed license - for example `firewall` or `lifecycle`"),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.ServerModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	solutions, httpResponse, err := d.Client.SolutionsAPI.GetLicensedSolutions(d.AuthContext(ctx)).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(
+			common.ERR_FAILED_READING_PRODUCT_LICENSE,
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	// Map api response to State
+	data.MapFromApi(ctx, d.BaseUrl, d.ServerVersion, solutions)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
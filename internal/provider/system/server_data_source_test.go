@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package system_test
+
+import (
+	"regexp"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerDataSource(t *testing.T) {
+	dataSourceName := "data.sonatypeiq_server.server"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: utils_test.ProviderConfig + `data "sonatypeiq_server" "server" {
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "server"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "base_url"),
+					resource.TestMatchResourceAttr(dataSourceName, "version", regexp.MustCompile(`^1\.\d+\.\d+-\d+$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "version_major", "1"),
+					resource.TestMatchResourceAttr(dataSourceName, "version_minor", regexp.MustCompile(`^\d{3}$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "version_patch"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "version_build"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "licensed_products.#"),
+				),
+			},
+		},
+	})
+}
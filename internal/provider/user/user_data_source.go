@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &userDataSource{}
+	_ datasource.DataSourceWithConfigure = &userDataSource{}
+)
+
+// UserDataSource is a helper function to simplify the provider implementation.
+func UserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+// userDataSource is the data source implementation.
+type userDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the data source.
+func (d *userDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get a User",
+		Attributes: map[string]tfschema.Attribute{
+			"id":       schema.DataSourceComputedString("Internal ID for Terraform State"),
+			"username": schema.DataSourceRequiredString("Username of the User"),
+			"realm": schema.DataSourceOptionalStringEnum(
+				fmt.Sprintf("Realm the User belongs to - defaults to '%s'", common.DEFAULT_USER_REALM),
+				common.USER_REALM_INTERNAL,
+				common.USER_REALM_SAML,
+				common.USER_REALM_OAUTH2,
+				common.USER_REALM_CROWD,
+			),
+			"first_name": schema.DataSourceComputedString("Users first name"),
+			"last_name":  schema.DataSourceComputedString("Users last name"),
+			"email":      schema.DataSourceComputedString("Users email address"),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.UserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	realm := common.DEFAULT_USER_REALM
+	if !data.Realm.IsNull() {
+		realm = data.Realm.ValueString()
+	}
+
+	apiResponse := readUser(d.AuthContext(ctx), d.Client, data.Username.ValueString(), realm, nil, &resp.Diagnostics)
+	if apiResponse == nil {
+		return
+	}
+
+	// Map api response to State
+	data.MapFromApi(apiResponse)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package user_test
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSources(t *testing.T) {
+	userName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	password := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	dataSourceName := "data.sonatypeiq_user.test"
+	usersDataSourceName := "data.sonatypeiq_users.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResource(userName, password, "") + fmt.Sprintf(`
+data "sonatypeiq_user" "test" {
+  username = sonatypeiq_user.test.username
+}
+
+data "sonatypeiq_users" "test" {
+  search = "%s"
+  depends_on = [sonatypeiq_user.test]
+}`, strings.ToUpper(userName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", fmt.Sprintf(common.USER_ID_FORMAT, common.USER_REALM_INTERNAL, userName)),
+					resource.TestCheckResourceAttr(dataSourceName, "username", userName),
+					resource.TestCheckResourceAttr(dataSourceName, "first_name", "Example"),
+					resource.TestCheckResourceAttr(dataSourceName, "last_name", "User"),
+					resource.TestCheckResourceAttr(dataSourceName, "email", fmt.Sprintf("%s@user.tld", userName)),
+					resource.TestCheckResourceAttr(dataSourceName, "realm", common.USER_REALM_INTERNAL),
+					resource.TestCheckResourceAttr(usersDataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(usersDataSourceName, "users.0.username", userName),
+				),
+			},
+		},
+	})
+}
@@ -236,17 +236,32 @@ func (r *userResource) ImportState(ctx context.Context, req resource.ImportState
 }
 
 func (r *userResource) doRead(ctx context.Context, username, realm string, respState *tfsdk.State, respDiags *diag.Diagnostics) *sonatypeiq.ApiUserDTO {
-	apiResponse, httpResponse, err := r.Client.UsersAPI.Get1(r.AuthContext(ctx), username).Realm(realm).Execute()
+	return readUser(r.AuthContext(ctx), r.Client, username, realm, respState, respDiags)
+}
+
+// readUser reads a User in a Realm. When the User does not exist it is removed from respState,
+// or reported as an error if there is no state to remove it from (i.e. from a data source).
+func readUser(ctx context.Context, client *sonatypeiq.APIClient, username, realm string, respState *tfsdk.State, respDiags *diag.Diagnostics) *sonatypeiq.ApiUserDTO {
+	apiResponse, httpResponse, err := client.UsersAPI.Get1(ctx, username).Realm(realm).Execute()
 
 	if err != nil {
-		if httpResponse.StatusCode == http.StatusNotFound {
-			respState.RemoveResource(ctx)
-			errors.HandleAPIWarning(
-				"User did not exist",
-				&err,
-				httpResponse,
-				respDiags,
-			)
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			if respState != nil {
+				respState.RemoveResource(ctx)
+				errors.HandleAPIWarning(
+					"User did not exist",
+					&err,
+					httpResponse,
+					respDiags,
+				)
+			} else {
+				errors.HandleAPIError(
+					fmt.Sprintf(common.ERR_USER_DID_NOT_EXIST, username),
+					&err,
+					httpResponse,
+					respDiags,
+				)
+			}
 		} else {
 			errors.HandleAPIError(
 				fmt.Sprintf(common.ERR_FAILED_READING_USER_AT_REALM, username, realm),
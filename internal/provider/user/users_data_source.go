@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// UsersDataSource is a helper function to simplify the provider implementation.
+func UsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to find Users",
+		Attributes: map[string]tfschema.Attribute{
+			"id": schema.DataSourceComputedString("Internal ID for Terraform State"),
+			"realm": schema.DataSourceOptionalStringEnum(
+				fmt.Sprintf("Realm to search for Users in - defaults to '%s'", common.DEFAULT_USER_REALM),
+				common.USER_REALM_INTERNAL,
+				common.USER_REALM_SAML,
+				common.USER_REALM_OAUTH2,
+				common.USER_REALM_CROWD,
+			),
+			"search": schema.DataSourceOptionalString("Only return Users whose username, name or email address contains this value (case insensitive)"),
+			"users": schema.DataSourceComputedListNestedAttribute(
+				"List of Users",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"id":         schema.DataSourceComputedString("Internal ID of the User"),
+						"username":   schema.DataSourceComputedString("Username of the User"),
+						"first_name": schema.DataSourceComputedString("Users first name"),
+						"last_name":  schema.DataSourceComputedString("Users last name"),
+						"email":      schema.DataSourceComputedString("Users email address"),
+						"realm":      schema.DataSourceComputedString("Realm the User belongs to"),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.UsersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	realm := common.DEFAULT_USER_REALM
+	if !data.Realm.IsNull() {
+		realm = data.Realm.ValueString()
+	}
+
+	apiResponse, httpResponse, err := d.Client.UsersAPI.GetAll2(d.AuthContext(ctx)).Realm(realm).Execute()
+
+	if err != nil || httpResponse.StatusCode != http.StatusOK {
+		errors.HandleAPIError(common.ERR_FAILED_READING_USERS, &err, httpResponse, &resp.Diagnostics)
+		return
+	}
+
+	// Filter
+	users := make([]sonatypeiq.ApiUserDTO, 0)
+	search := strings.ToLower(data.Search.ValueString())
+	for _, user := range apiResponse.Users {
+		if search == "" || userMatches(user, search) {
+			users = append(users, user)
+		}
+	}
+
+	// Map api response to State
+	data.ID = types.StringValue("all-users")
+	data.MapFromApi(users)
+
+	// Set state
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// userMatches returns whether the lower-cased search term is in the User's username, name or email address
+func userMatches(user sonatypeiq.ApiUserDTO, search string) bool {
+	for _, value := range []string{
+		user.GetUsername(),
+		user.GetFirstName(),
+		user.GetLastName(),
+		user.GetFirstName() + " " + user.GetLastName(),
+		user.GetEmail(),
+	} {
+		if strings.Contains(strings.ToLower(value), search) {
+			return true
+		}
+	}
+	return false
+}
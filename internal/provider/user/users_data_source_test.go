@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package user_test
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-sonatypeiq/internal/provider/common"
+	"terraform-provider-sonatypeiq/internal/provider/user"
+	utils_test "terraform-provider-sonatypeiq/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	sonatypeiq "github.com/sonatype-nexus-community/nexus-iq-api-client-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccUsersDataSource(t *testing.T) {
+	userName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	password := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	dataSourceName := "data.sonatypeiq_users.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Search by email address
+			{
+				Config: testAccUserResource(userName, password, "") + testAccUsersDataSource(strings.ToUpper(userName)+"@USER.TLD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "all-users"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.id", fmt.Sprintf(common.USER_ID_FORMAT, common.USER_REALM_INTERNAL, userName)),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.username", userName),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.first_name", "Example"+userName),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.last_name", "User"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.email", fmt.Sprintf("%s@user.tld", userName)),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.realm", common.USER_REALM_INTERNAL),
+				),
+			},
+			// Search by full name
+			{
+				Config: testAccUserResource(userName, password, userName) + testAccUsersDataSource(fmt.Sprintf("example%s user", userName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.username", userName),
+				),
+			},
+			// No match
+			{
+				Config: testAccUserResource(userName, password, userName) + testAccUsersDataSource(userName+"-no-such-user"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsersDataSource(search string) string {
+	return fmt.Sprintf(`
+data "sonatypeiq_users" "test" {
+  realm      = "%s"
+  search     = "%s"
+  depends_on = [sonatypeiq_user.test]
+}`, common.USER_REALM_INTERNAL, search)
+}
+
+func TestUserMatches(t *testing.T) {
+	u := sonatypeiq.ApiUserDTO{}
+	u.SetUsername("jdoe")
+	u.SetFirstName("Jane")
+	u.SetLastName("Doe")
+	u.SetEmail("jane.doe@example.com")
+
+	tests := []struct {
+		search string
+		want   bool
+	}{
+		{"jdoe", true},
+		{"jane", true},
+		{"doe", true},
+		{"jane doe", true},
+		{"example.com", true},
+		{"smith", false},
+		{"jane  doe", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, user.UserMatches(u, tt.search), "search %q", tt.search)
+	}
+
+	// Users without optional details only match on username
+	assert.True(t, user.UserMatches(sonatypeiq.ApiUserDTO{Username: sonatypeiq.PtrString("admin")}, "adm"))
+	assert.False(t, user.UserMatches(sonatypeiq.ApiUserDTO{Username: sonatypeiq.PtrString("admin")}, "example"))
+}